@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"medods/internal/domain/request"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+const adminUserAgent = "admin-cli"
+
+const adminUsage = `usage:
+  token issue [-user-agent ua] [-ip ip] <guid>
+  token blacklist <access_token>
+  sessions list <guid>
+  sessions revoke <guid> <session_id>
+  sessions revoke-all <guid>
+  keys rotate
+  purge`
+
+// runAdmin run command of operator, it works with the same storage and services as server
+func runAdmin(command string, args []string) error {
+	//purge has no subcommand, others have
+	if command != "purge" {
+		if len(args) == 0 {
+			return errors.New(adminUsage)
+		}
+		command, args = command+" "+args[0], args[1:]
+	}
+
+	svc, err := newServices()
+	if err != nil {
+		return err
+	}
+	//actions of operator are audited with user agent of cli
+	ctx := request.WithData(context.Background(), request.RequestData{UserAgent: adminUserAgent})
+
+	switch command {
+	case "token issue":
+		return issueToken(ctx, svc, args)
+	case "token blacklist":
+		if len(args) != 1 {
+			return errors.New(adminUsage)
+		}
+		if err = svc.ts.BlockToken(ctx, args[0]); err != nil {
+			return err
+		}
+		log.Printf("access token was blacklisted")
+	case "sessions list":
+		if len(args) != 1 {
+			return errors.New(adminUsage)
+		}
+		return listSessions(ctx, svc, args[0])
+	case "sessions revoke":
+		if len(args) != 2 {
+			return errors.New(adminUsage)
+		}
+		if err = svc.ts.RevokeSession(ctx, args[0], args[1]); err != nil {
+			return err
+		}
+		log.Printf("session %s was revoked", args[1])
+	case "sessions revoke-all":
+		if len(args) != 1 {
+			return errors.New(adminUsage)
+		}
+		if err = svc.ts.RevokeAllSessions(ctx, args[0]); err != nil {
+			return err
+		}
+		log.Printf("all sessions of user %s were revoked", args[0])
+	case "keys rotate":
+		created, err := svc.keyRing.Rotate(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Println(created.ID)
+	case "purge":
+		n, err := svc.ts.PurgeExpired(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("%d expired refresh tokens were removed", n)
+		if n, err = svc.ts.PurgeBlacklist(ctx); err != nil {
+			return err
+		}
+		log.Printf("%d blacklist entries of expired tokens were removed", n)
+	default:
+		return errors.New(adminUsage)
+	}
+	return nil
+}
+
+// issueToken create new session of user and print its token pair
+func issueToken(ctx context.Context, svc *services, args []string) error {
+	fs := flag.NewFlagSet("token issue", flag.ContinueOnError)
+	userAgent := fs.String("user-agent", adminUserAgent, "user agent of client which will refresh tokens")
+	ip := fs.String("ip", "127.0.0.1", "ip address of client")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New(adminUsage)
+	}
+
+	pair, err := svc.us.Authorize(ctx, fs.Arg(0), request.RequestData{UserAgent: *userAgent, IP: *ip})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("access_token: %s\nrefresh_token: %s\n", pair.AccessToken, pair.RefreshToken)
+	return nil
+}
+
+func listSessions(ctx context.Context, svc *services, userGuid string) error {
+	sessions, err := svc.ts.Sessions(ctx, userGuid)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tCREATED AT\tLAST REFRESHED AT\tIP\tUSER AGENT")
+	for _, v := range sessions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", v.ID, v.CreatedAt.Format(time.RFC3339),
+			v.LastRefreshedAt.Format(time.RFC3339), v.IP, v.UserAgent)
+	}
+	return w.Flush()
+}
@@ -15,15 +15,190 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v2"
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	AdminBasicScopes  = "adminBasic.Scopes"
+	ClientBasicScopes = "clientBasic.Scopes"
+)
+
+// Defines values for AuditEventOutcome.
+const (
+	Failure AuditEventOutcome = "failure"
+	Success AuditEventOutcome = "success"
+)
+
+// Defines values for AuditEventType.
+const (
+	AuditEventTypeAuthorize         AuditEventType = "authorize"
+	AuditEventTypeLogout            AuditEventType = "logout"
+	AuditEventTypeRefresh           AuditEventType = "refresh"
+	AuditEventTypeSessionRevoked    AuditEventType = "session_revoked"
+	AuditEventTypeTokenReuse        AuditEventType = "token_reuse"
+	AuditEventTypeTokenRevoked      AuditEventType = "token_revoked"
+	AuditEventTypeUserAgentMismatch AuditEventType = "user_agent_mismatch"
+)
+
+// Defines values for IntrospectionRequestTokenTypeHint.
+const (
+	IntrospectionRequestTokenTypeHintAccessToken  IntrospectionRequestTokenTypeHint = "access_token"
+	IntrospectionRequestTokenTypeHintRefreshToken IntrospectionRequestTokenTypeHint = "refresh_token"
+)
+
+// Defines values for RevocationRequestTokenTypeHint.
+const (
+	RevocationRequestTokenTypeHintAccessToken  RevocationRequestTokenTypeHint = "access_token"
+	RevocationRequestTokenTypeHintRefreshToken RevocationRequestTokenTypeHint = "refresh_token"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Dead      WebhookDeliveryStatus = "dead"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeIpChanged          WebhookEventType = "ip.changed"
+	WebhookEventTypeSessionCreated     WebhookEventType = "session.created"
+	WebhookEventTypeSessionRevoked     WebhookEventType = "session.revoked"
+	WebhookEventTypeTokenReuseDetected WebhookEventType = "token.reuse_detected"
+)
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Id         int64             `json:"id"`
+	Ip         string            `json:"ip"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	Outcome    AuditEventOutcome `json:"outcome"`
+
+	// Reason cause of failure or revocation
+	Reason    string         `json:"reason"`
+	SessionId string         `json:"session_id"`
+	Type      AuditEventType `json:"type"`
+	UserAgent string         `json:"user_agent"`
+	UserGuid  string         `json:"user_guid"`
+}
+
+// AuditEventOutcome defines model for AuditEvent.Outcome.
+type AuditEventOutcome string
+
+// AuditEventType defines model for AuditEventType.
+type AuditEventType string
+
+// IntrospectionRequest defines model for IntrospectionRequest.
+type IntrospectionRequest struct {
+	Token         string                             `form:"token" json:"token"`
+	TokenTypeHint *IntrospectionRequestTokenTypeHint `form:"token_type_hint" json:"token_type_hint,omitempty"`
+}
+
+// IntrospectionRequestTokenTypeHint defines model for IntrospectionRequest.TokenTypeHint.
+type IntrospectionRequestTokenTypeHint string
+
+// IntrospectionResponse defines model for IntrospectionResponse.
+type IntrospectionResponse struct {
+	Active   bool      `json:"active"`
+	Aud      *[]string `json:"aud,omitempty"`
+	ClientId *string   `json:"client_id,omitempty"`
+	Exp      *int64    `json:"exp,omitempty"`
+	Iat      *int64    `json:"iat,omitempty"`
+
+	// Ip ip address of session
+	Ip  *string `json:"ip,omitempty"`
+	Iss *string `json:"iss,omitempty"`
+	Jti *string `json:"jti,omitempty"`
+
+	// Sid id of session
+	Sid *string `json:"sid,omitempty"`
+	Sub *string `json:"sub,omitempty"`
+
+	// TokenType Bearer for access token, it is absent for refresh token
+	TokenType *string `json:"token_type,omitempty"`
+
+	// UserAgent user agent of session
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// JWK defines model for JWK.
+type JWK struct {
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+	Y   *string `json:"y,omitempty"`
+}
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// OpenIDConfiguration defines model for OpenIDConfiguration.
+type OpenIDConfiguration struct {
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	ClaimsSupported       []string `json:"claims_supported"`
+
+	// GrantTypesSupported empty, authorize and refresh endpoints are custom and implement none of standard grants
+	GrantTypesSupported []string `json:"grant_types_supported"`
+
+	// IdTokenSigningAlgValuesSupported asymmetric algorithms of keys published in jwks, symmetric keys are never advertised
+	IdTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	Issuer                           string   `json:"issuer"`
+	JwksUri                          string   `json:"jwks_uri"`
+
+	// ResponseTypesSupported empty, authorize endpoint is custom and implements none of standard response types
+	ResponseTypesSupported []string `json:"response_types_supported"`
+	ScopesSupported        []string `json:"scopes_supported"`
+	SubjectTypesSupported  []string `json:"subject_types_supported"`
+	TokenEndpoint          string   `json:"token_endpoint"`
+	UserinfoEndpoint       string   `json:"userinfo_endpoint"`
+}
+
+// RevocationRequest defines model for RevocationRequest.
+type RevocationRequest struct {
+	Token         string                          `form:"token" json:"token"`
+	TokenTypeHint *RevocationRequestTokenTypeHint `form:"token_type_hint" json:"token_type_hint,omitempty"`
+}
+
+// RevocationRequestTokenTypeHint defines model for RevocationRequest.TokenTypeHint.
+type RevocationRequestTokenTypeHint string
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current session of access token used for request
+	Current         bool      `json:"current"`
+	Id              string    `json:"id"`
+	Ip              string    `json:"ip"`
+	LastRefreshedAt time.Time `json:"last_refreshed_at"`
+	UserAgent       string    `json:"user_agent"`
+}
+
 // TokensPair defines model for TokensPair.
 type TokensPair struct {
-	AccessToken  string `json:"access_token"`
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn lifetime of access token in seconds
+	ExpiresIn *int `json:"expires_in,omitempty"`
+
+	// IdToken OpenID Connect id token, returned by /authorize when scope contains openid
+	IdToken *string `json:"id_token,omitempty"`
+
+	// RefreshExpiresIn lifetime of refresh token in seconds, it is limited by absolute lifetime of session
+	RefreshExpiresIn *int `json:"refresh_expires_in,omitempty"`
+
+	// RefreshToken token in format selector.verifier
 	RefreshToken string `json:"refresh_token"`
 }
 
@@ -32,6 +207,81 @@ type UserGuid struct {
 	UserGuid *string `json:"user_guid,omitempty"`
 }
 
+// UserInfo defines model for UserInfo.
+type UserInfo struct {
+	Sub string `json:"sub"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// AttemptLog attempts of delivery, returned only for one delivery
+	AttemptLog *[]WebhookDeliveryAttempt `json:"attempt_log,omitempty"`
+
+	// Attempts number of attempts made by dispatcher, replays aren't counted
+	Attempts      int              `json:"attempts"`
+	CreatedAt     time.Time        `json:"created_at"`
+	DeliveredAt   *time.Time       `json:"delivered_at,omitempty"`
+	EventId       string           `json:"event_id"`
+	EventType     WebhookEventType `json:"event_type"`
+	Id            int64            `json:"id"`
+	LastError     string           `json:"last_error"`
+	NextAttemptAt time.Time        `json:"next_attempt_at"`
+
+	// Payload body of webhook
+	Payload        map[string]interface{} `json:"payload"`
+	Status         WebhookDeliveryStatus  `json:"status"`
+	SubscriptionId string                 `json:"subscription_id"`
+}
+
+// WebhookDeliveryAttempt defines model for WebhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	CreatedAt time.Time `json:"created_at"`
+	Error     string    `json:"error"`
+	Id        int64     `json:"id"`
+	LatencyMs int64     `json:"latency_ms"`
+
+	// Replay attempt was made by replay
+	Replay         bool              `json:"replay"`
+	RequestHeaders map[string]string `json:"request_headers"`
+
+	// ResponseStatus status of response, 0 when response wasn't received
+	ResponseStatus int    `json:"response_status"`
+	Url            string `json:"url"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	Active     bool               `json:"active"`
+	CreatedAt  time.Time          `json:"created_at"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	Id         string             `json:"id"`
+
+	// Secret key of signatures, returned only on creation
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookSubscriptionCreate defines model for WebhookSubscriptionCreate.
+type WebhookSubscriptionCreate struct {
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Url https url of public host, plain http and internal hosts are allowed only by WEBHOOK_ALLOW_INSECURE_TARGETS
+	Url string `json:"url"`
+}
+
+// WebhookSubscriptionUpdate defines model for WebhookSubscriptionUpdate.
+type WebhookSubscriptionUpdate struct {
+	Active     *bool               `json:"active,omitempty"`
+	EventTypes *[]WebhookEventType `json:"event_types,omitempty"`
+	Url        *string             `json:"url,omitempty"`
+}
+
 // UserAgent defines model for UserAgent.
 type UserAgent = string
 
@@ -43,15 +293,68 @@ type N400 struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// N401 defines model for 401.
+type N401 struct {
+	Error *string `json:"error,omitempty"`
+}
+
+// N404 defines model for 404.
+type N404 struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// N409 defines model for 409.
+type N409 struct {
+	Message *string `json:"message,omitempty"`
+}
+
 // N500 defines model for 500.
 type N500 struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
+	UserGuid *string         `form:"user_guid,omitempty" json:"user_guid,omitempty"`
+	Type     *AuditEventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// From start of time range, inclusive
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To end of time range, exclusive
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit size of page, 100 by default and 1000 at most
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAuditEventsExportParams defines parameters for GetAuditEventsExport.
+type GetAuditEventsExportParams struct {
+	UserGuid *string         `form:"user_guid,omitempty" json:"user_guid,omitempty"`
+	Type     *AuditEventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// From start of time range, inclusive
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To end of time range, exclusive
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // PostAuthorizeParams defines parameters for PostAuthorize.
 type PostAuthorizeParams struct {
-	Guid      UserGuidParam `form:"guid" json:"guid"`
-	UserAgent UserAgent     `json:"User-Agent"`
+	Guid UserGuidParam `form:"guid" json:"guid"`
+
+	// Scope space separated scopes, id token is returned when scope contains openid. Scope openid is rejected when active signing key is symmetric
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// Nonce value is copied to nonce claim of id token
+	Nonce *string `form:"nonce,omitempty" json:"nonce,omitempty"`
+
+	// ClientId client which requests tokens, stored as client_id claim and used as audience of id token.
+	// It must match client authenticated by HTTP Basic credentials
+	ClientId  *string   `form:"client_id,omitempty" json:"client_id,omitempty"`
+	UserAgent UserAgent `json:"User-Agent"`
 }
 
 // PostRefreshParams defines parameters for PostRefresh.
@@ -69,9 +372,52 @@ type PostUserLogoutParams struct {
 	Authorization string `json:"Authorization"`
 }
 
+// PostUserLogoutAllParams defines parameters for PostUserLogoutAll.
+type PostUserLogoutAllParams struct {
+	Authorization string `json:"Authorization"`
+}
+
+// GetUserSessionsParams defines parameters for GetUserSessions.
+type GetUserSessionsParams struct {
+	Authorization string `json:"Authorization"`
+}
+
+// DeleteUserSessionsIdParams defines parameters for DeleteUserSessionsId.
+type DeleteUserSessionsIdParams struct {
+	Authorization string `json:"Authorization"`
+}
+
+// GetUserUserinfoParams defines parameters for GetUserUserinfo.
+type GetUserUserinfoParams struct {
+	Authorization string `json:"Authorization"`
+}
+
+// GetWebhooksDeliveriesParams defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParams struct {
+	EventType      *WebhookEventType      `form:"event_type,omitempty" json:"event_type,omitempty"`
+	Status         *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+	SubscriptionId *string                `form:"subscription_id,omitempty" json:"subscription_id,omitempty"`
+
+	// Limit size of page, 50 by default and 500 at most
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostIntrospectFormdataRequestBody defines body for PostIntrospect for application/x-www-form-urlencoded ContentType.
+type PostIntrospectFormdataRequestBody = IntrospectionRequest
+
 // PostRefreshJSONRequestBody defines body for PostRefresh for application/json ContentType.
 type PostRefreshJSONRequestBody = TokensPair
 
+// PostRevokeFormdataRequestBody defines body for PostRevoke for application/x-www-form-urlencoded ContentType.
+type PostRevokeFormdataRequestBody = RevocationRequest
+
+// PostWebhooksSubscriptionsJSONRequestBody defines body for PostWebhooksSubscriptions for application/json ContentType.
+type PostWebhooksSubscriptionsJSONRequestBody = WebhookSubscriptionCreate
+
+// PatchWebhooksSubscriptionsIdJSONRequestBody defines body for PatchWebhooksSubscriptionsId for application/json ContentType.
+type PatchWebhooksSubscriptionsIdJSONRequestBody = WebhookSubscriptionUpdate
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -145,23 +491,85 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWellKnownOpenidConfiguration request
+	GetWellKnownOpenidConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditEvents request
+	GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditEventsExport request
+	GetAuditEventsExport(ctx context.Context, params *GetAuditEventsExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAuthorize request
 	PostAuthorize(ctx context.Context, params *PostAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostIntrospectWithBody request with any body
+	PostIntrospectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostIntrospectWithFormdataBody(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostRefreshWithBody request with any body
 	PostRefreshWithBody(ctx context.Context, params *PostRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostRefresh(ctx context.Context, params *PostRefreshParams, body PostRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostRevokeWithBody request with any body
+	PostRevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRevokeWithFormdataBody(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUserGuid request
 	GetUserGuid(ctx context.Context, params *GetUserGuidParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostUserLogout request
 	PostUserLogout(ctx context.Context, params *PostUserLogoutParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUserLogoutAll request
+	PostUserLogoutAll(ctx context.Context, params *PostUserLogoutAllParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserSessions request
+	GetUserSessions(ctx context.Context, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserSessionsId request
+	DeleteUserSessionsId(ctx context.Context, id string, params *DeleteUserSessionsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserUserinfo request
+	GetUserUserinfo(ctx context.Context, params *GetUserUserinfoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksDeliveries request
+	GetWebhooksDeliveries(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksDeliveriesId request
+	GetWebhooksDeliveriesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksDeliveriesIdReplay request
+	PostWebhooksDeliveriesIdReplay(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksSubscriptions request
+	GetWebhooksSubscriptions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksSubscriptionsWithBody request with any body
+	PostWebhooksSubscriptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksSubscriptions(ctx context.Context, body PostWebhooksSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhooksSubscriptionsId request
+	DeleteWebhooksSubscriptionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksSubscriptionsId request
+	GetWebhooksSubscriptionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchWebhooksSubscriptionsIdWithBody request with any body
+	PatchWebhooksSubscriptionsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchWebhooksSubscriptionsId(ctx context.Context, id string, body PatchWebhooksSubscriptionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostAuthorize(ctx context.Context, params *PostAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAuthorizeRequest(c.Server, params)
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +580,8 @@ func (c *Client) PostAuthorize(ctx context.Context, params *PostAuthorizeParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostRefreshWithBody(ctx context.Context, params *PostRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRefreshRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) GetWellKnownOpenidConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownOpenidConfigurationRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -184,8 +592,8 @@ func (c *Client) PostRefreshWithBody(ctx context.Context, params *PostRefreshPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostRefresh(ctx context.Context, params *PostRefreshParams, body PostRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRefreshRequest(c.Server, params, body)
+func (c *Client) GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -196,8 +604,8 @@ func (c *Client) PostRefresh(ctx context.Context, params *PostRefreshParams, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserGuid(ctx context.Context, params *GetUserGuidParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserGuidRequest(c.Server, params)
+func (c *Client) GetAuditEventsExport(ctx context.Context, params *GetAuditEventsExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditEventsExportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -208,8 +616,8 @@ func (c *Client) GetUserGuid(ctx context.Context, params *GetUserGuidParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUserLogout(ctx context.Context, params *PostUserLogoutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUserLogoutRequest(c.Server, params)
+func (c *Client) PostAuthorize(ctx context.Context, params *PostAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthorizeRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -220,934 +628,5359 @@ func (c *Client) PostUserLogout(ctx context.Context, params *PostUserLogoutParam
 	return c.Client.Do(req)
 }
 
-// NewPostAuthorizeRequest generates requests for PostAuthorize
-func NewPostAuthorizeRequest(server string, params *PostAuthorizeParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostIntrospectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntrospectRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/authorize")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostIntrospectWithFormdataBody(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntrospectRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "guid", runtime.ParamLocationQuery, params.Guid); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostRefreshWithBody(ctx context.Context, params *PostRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRefreshRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("User-Agent", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostRefreshRequest calls the generic PostRefresh builder with application/json body
-func NewPostRefreshRequest(server string, params *PostRefreshParams, body PostRefreshJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostRefresh(ctx context.Context, params *PostRefreshParams, body PostRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRefreshRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostRefreshRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostRefreshRequestWithBody generates requests for PostRefresh with any type of body
-func NewPostRefreshRequestWithBody(server string, params *PostRefreshParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostRevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostRevokeWithFormdataBody(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("User-Agent", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetUserGuidRequest generates requests for GetUserGuid
-func NewGetUserGuidRequest(server string, params *GetUserGuidParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetUserGuid(ctx context.Context, params *GetUserGuidParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserGuidRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/user/guid")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostUserLogout(ctx context.Context, params *PostUserLogoutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUserLogoutRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Authorization", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostUserLogoutRequest generates requests for PostUserLogout
-func NewPostUserLogoutRequest(server string, params *PostUserLogoutParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostUserLogoutAll(ctx context.Context, params *PostUserLogoutAllParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUserLogoutAllRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/user/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) GetUserSessions(ctx context.Context, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserSessionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Authorization", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) DeleteUserSessionsId(ctx context.Context, id string, params *DeleteUserSessionsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserSessionsIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) GetUserUserinfo(ctx context.Context, params *GetUserUserinfoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserUserinfoRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
-
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostAuthorizeWithResponse request
-	PostAuthorizeWithResponse(ctx context.Context, params *PostAuthorizeParams, reqEditors ...RequestEditorFn) (*PostAuthorizeResponse, error)
-
-	// PostRefreshWithBodyWithResponse request with any body
-	PostRefreshWithBodyWithResponse(ctx context.Context, params *PostRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error)
-
-	PostRefreshWithResponse(ctx context.Context, params *PostRefreshParams, body PostRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error)
-
-	// GetUserGuidWithResponse request
-	GetUserGuidWithResponse(ctx context.Context, params *GetUserGuidParams, reqEditors ...RequestEditorFn) (*GetUserGuidResponse, error)
-
-	// PostUserLogoutWithResponse request
-	PostUserLogoutWithResponse(ctx context.Context, params *PostUserLogoutParams, reqEditors ...RequestEditorFn) (*PostUserLogoutResponse, error)
-}
-
-type PostAuthorizeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TokensPair
-	JSON400      *N400
-	JSON500      *N500
-}
-
-// Status returns HTTPResponse.Status
-func (r PostAuthorizeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetWebhooksDeliveries(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksDeliveriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthorizeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostRefreshResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TokensPair
-	JSON400      *N400
-	JSON500      *N500
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostRefreshResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetWebhooksDeliveriesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksDeliveriesIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostRefreshResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetUserGuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserGuid
-	JSON400      *N400
-	JSON500      *N500
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserGuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostWebhooksDeliveriesIdReplay(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksDeliveriesIdReplayRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserGuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostUserLogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON500      *N500
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostUserLogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetWebhooksSubscriptions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksSubscriptionsRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostUserLogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
+	return c.Client.Do(req)
 }
 
-// PostAuthorizeWithResponse request returning *PostAuthorizeResponse
-func (c *ClientWithResponses) PostAuthorizeWithResponse(ctx context.Context, params *PostAuthorizeParams, reqEditors ...RequestEditorFn) (*PostAuthorizeResponse, error) {
-	rsp, err := c.PostAuthorize(ctx, params, reqEditors...)
+func (c *Client) PostWebhooksSubscriptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksSubscriptionsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthorizeResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// PostRefreshWithBodyWithResponse request with arbitrary body returning *PostRefreshResponse
-func (c *ClientWithResponses) PostRefreshWithBodyWithResponse(ctx context.Context, params *PostRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error) {
-	rsp, err := c.PostRefreshWithBody(ctx, params, contentType, body, reqEditors...)
+func (c *Client) PostWebhooksSubscriptions(ctx context.Context, body PostWebhooksSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksSubscriptionsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostRefreshResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) PostRefreshWithResponse(ctx context.Context, params *PostRefreshParams, body PostRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error) {
-	rsp, err := c.PostRefresh(ctx, params, body, reqEditors...)
+func (c *Client) DeleteWebhooksSubscriptionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhooksSubscriptionsIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostRefreshResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// GetUserGuidWithResponse request returning *GetUserGuidResponse
-func (c *ClientWithResponses) GetUserGuidWithResponse(ctx context.Context, params *GetUserGuidParams, reqEditors ...RequestEditorFn) (*GetUserGuidResponse, error) {
-	rsp, err := c.GetUserGuid(ctx, params, reqEditors...)
+func (c *Client) GetWebhooksSubscriptionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksSubscriptionsIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserGuidResponse(rsp)
-}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-// PostUserLogoutWithResponse request returning *PostUserLogoutResponse
-func (c *ClientWithResponses) PostUserLogoutWithResponse(ctx context.Context, params *PostUserLogoutParams, reqEditors ...RequestEditorFn) (*PostUserLogoutResponse, error) {
-	rsp, err := c.PostUserLogout(ctx, params, reqEditors...)
+func (c *Client) PatchWebhooksSubscriptionsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchWebhooksSubscriptionsIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostUserLogoutResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// ParsePostAuthorizeResponse parses an HTTP response from a PostAuthorizeWithResponse call
-func ParsePostAuthorizeResponse(rsp *http.Response) (*PostAuthorizeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *Client) PatchWebhooksSubscriptionsId(ctx context.Context, id string, body PatchWebhooksSubscriptionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchWebhooksSubscriptionsIdRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	response := &PostAuthorizeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TokensPair
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePostRefreshResponse parses an HTTP response from a PostRefreshWithResponse call
-func ParsePostRefreshResponse(rsp *http.Response) (*PostRefreshResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostRefreshResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TokensPair
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewGetWellKnownOpenidConfigurationRequest generates requests for GetWellKnownOpenidConfiguration
+func NewGetWellKnownOpenidConfigurationRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/.well-known/openid-configuration")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetUserGuidResponse parses an HTTP response from a GetUserGuidWithResponse call
-func ParseGetUserGuidResponse(rsp *http.Response) (*GetUserGuidResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUserGuidResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserGuid
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewGetAuditEventsRequest generates requests for GetAuditEvents
+func NewGetAuditEventsRequest(server string, params *GetAuditEventsParams) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/audit/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePostUserLogoutResponse parses an HTTP response from a PostUserLogoutWithResponse call
-func ParsePostUserLogoutResponse(rsp *http.Response) (*PostUserLogoutResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostUserLogoutResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserGuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_guid", runtime.ParamLocationQuery, *params.UserGuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON500 = &dest
 
-	}
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return response, nil
-}
+		}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Authorize user
-	// (POST /authorize)
-	PostAuthorize(c *fiber.Ctx, params PostAuthorizeParams) error
-	// refresh token
-	// (POST /refresh)
-	PostRefresh(c *fiber.Ctx, params PostRefreshParams) error
-	// get user guid by access token
-	// (GET /user/guid)
-	GetUserGuid(c *fiber.Ctx, params GetUserGuidParams) error
-	// logout by access token
-	// (POST /user/logout)
-	PostUserLogout(c *fiber.Ctx, params PostUserLogoutParams) error
-}
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+		}
 
-type MiddlewareFunc fiber.Handler
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// PostAuthorize operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthorize(c *fiber.Ctx) error {
+		}
 
-	var err error
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostAuthorizeParams
+		}
 
-	var query url.Values
-	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+		return nil, err
 	}
 
-	// ------------- Required query parameter "guid" -------------
+	return req, nil
+}
 
-	if paramValue := c.Query("guid"); paramValue != "" {
+// NewGetAuditEventsExportRequest generates requests for GetAuditEventsExport
+func NewGetAuditEventsExportRequest(server string, params *GetAuditEventsExportParams) (*http.Request, error) {
+	var err error
 
-	} else {
-		err = fmt.Errorf("Query argument guid is required, but not found")
-		c.Status(fiber.StatusBadRequest).JSON(err)
-		return err
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "guid", query, &params.Guid)
+	operationPath := fmt.Sprintf("/audit/events/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter guid: %w", err).Error())
+		return nil, err
 	}
 
-	headers := c.GetReqHeaders()
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// ------------- Required header parameter "User-Agent" -------------
-	if value, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
-		var UserAgent UserAgent
+		if params.UserGuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_guid", runtime.ParamLocationQuery, *params.UserGuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", value, &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter User-Agent: %w", err).Error())
 		}
 
-		params.UserAgent = UserAgent
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	} else {
-		err = fmt.Errorf("Header parameter User-Agent is required, but not found: %w", err)
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
+		}
 
-	return siw.Handler.PostAuthorize(c, params)
-}
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// PostRefresh operation middleware
-func (siw *ServerInterfaceWrapper) PostRefresh(c *fiber.Ctx) error {
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAuthorizeRequest generates requests for PostAuthorize
+func NewPostAuthorizeRequest(server string, params *PostAuthorizeParams) (*http.Request, error) {
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostRefreshParams
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	headers := c.GetReqHeaders()
+	operationPath := fmt.Sprintf("/authorize")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ------------- Required header parameter "User-Agent" -------------
-	if value, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
-		var UserAgent UserAgent
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", value, &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter User-Agent: %w", err).Error())
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "guid", runtime.ParamLocationQuery, params.Guid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
 
-		params.UserAgent = UserAgent
+		if params.Scope != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope", runtime.ParamLocationQuery, *params.Scope); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	} else {
-		err = fmt.Errorf("Header parameter User-Agent is required, but not found: %w", err)
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
+		}
 
-	return siw.Handler.PostRefresh(c, params)
-}
+		if params.Nonce != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nonce", runtime.ParamLocationQuery, *params.Nonce); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// GetUserGuid operation middleware
-func (siw *ServerInterfaceWrapper) GetUserGuid(c *fiber.Ctx) error {
+		}
 
-	var err error
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserGuidParams
+		}
 
-	headers := c.GetReqHeaders()
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// ------------- Required header parameter "Authorization" -------------
-	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
-		var Authorization string
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
 		if err != nil {
-			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
+			return nil, err
 		}
 
-		params.Authorization = Authorization
+		req.Header.Set("User-Agent", headerParam0)
 
-	} else {
-		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	return siw.Handler.GetUserGuid(c, params)
+	return req, nil
 }
 
-// PostUserLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostUserLogout(c *fiber.Ctx) error {
+// NewPostIntrospectRequestWithFormdataBody calls the generic PostIntrospect builder with application/x-www-form-urlencoded body
+func NewPostIntrospectRequestWithFormdataBody(server string, body PostIntrospectFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostIntrospectRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
 
+// NewPostIntrospectRequestWithBody generates requests for PostIntrospect with any type of body
+func NewPostIntrospectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostUserLogoutParams
-
-	headers := c.GetReqHeaders()
-
-	// ------------- Required header parameter "Authorization" -------------
-	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
-		var Authorization string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
-		}
+	operationPath := fmt.Sprintf("/introspect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		params.Authorization = Authorization
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	} else {
-		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return siw.Handler.PostUserLogout(c, params)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// FiberServerOptions provides options for the Fiber server.
-type FiberServerOptions struct {
-	BaseURL     string
-	Middlewares []MiddlewareFunc
+	return req, nil
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router fiber.Router, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+// NewPostRefreshRequest calls the generic PostRefresh builder with application/json body
+func NewPostRefreshRequest(server string, params *PostRefreshParams, body PostRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRefreshRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+// NewPostRefreshRequestWithBody generates requests for PostRefresh with any type of body
+func NewPostRefreshRequestWithBody(server string, params *PostRefreshParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, m := range options.Middlewares {
-		router.Use(fiber.Handler(m))
+	operationPath := fmt.Sprintf("/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	router.Post(options.BaseURL+"/authorize", wrapper.PostAuthorize)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	router.Post(options.BaseURL+"/refresh", wrapper.PostRefresh)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	router.Get(options.BaseURL+"/user/guid", wrapper.GetUserGuid)
+	req.Header.Add("Content-Type", contentType)
 
-	router.Post(options.BaseURL+"/user/logout", wrapper.PostUserLogout)
+	if params != nil {
 
-}
+		var headerParam0 string
 
-type N400JSONResponse struct {
-	Message *string `json:"message,omitempty"`
-}
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
 
-type N500JSONResponse struct {
-	Message *string `json:"message,omitempty"`
-}
+		req.Header.Set("User-Agent", headerParam0)
 
-type PostAuthorizeRequestObject struct {
-	Params PostAuthorizeParams
+	}
+
+	return req, nil
 }
 
-type PostAuthorizeResponseObject interface {
-	VisitPostAuthorizeResponse(ctx *fiber.Ctx) error
+// NewPostRevokeRequestWithFormdataBody calls the generic PostRevoke builder with application/x-www-form-urlencoded body
+func NewPostRevokeRequestWithFormdataBody(server string, body PostRevokeFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostRevokeRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type PostAuthorize200JSONResponse TokensPair
+// NewPostRevokeRequestWithBody generates requests for PostRevoke with any type of body
+func NewPostRevokeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-func (response PostAuthorize200JSONResponse) VisitPostAuthorizeResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	return ctx.JSON(&response)
+	operationPath := fmt.Sprintf("/revoke")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type PostAuthorize400JSONResponse struct{ N400JSONResponse }
+// NewGetUserGuidRequest generates requests for GetUserGuid
+func NewGetUserGuidRequest(server string, params *GetUserGuidParams) (*http.Request, error) {
+	var err error
 
-func (response PostAuthorize400JSONResponse) VisitPostAuthorizeResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	return ctx.JSON(&response)
+	operationPath := fmt.Sprintf("/user/guid")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostUserLogoutRequest generates requests for PostUserLogout
+func NewPostUserLogoutRequest(server string, params *PostUserLogoutParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostUserLogoutAllRequest generates requests for PostUserLogoutAll
+func NewPostUserLogoutAllRequest(server string, params *PostUserLogoutAllParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/logout-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserSessionsRequest generates requests for GetUserSessions
+func NewGetUserSessionsRequest(server string, params *GetUserSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteUserSessionsIdRequest generates requests for DeleteUserSessionsId
+func NewDeleteUserSessionsIdRequest(server string, id string, params *DeleteUserSessionsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserUserinfoRequest generates requests for GetUserUserinfo
+func NewGetUserUserinfoRequest(server string, params *GetUserUserinfoParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/userinfo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksDeliveriesRequest generates requests for GetWebhooksDeliveries
+func NewGetWebhooksDeliveriesRequest(server string, params *GetWebhooksDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deliveries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EventType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event_type", runtime.ParamLocationQuery, *params.EventType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SubscriptionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subscription_id", runtime.ParamLocationQuery, *params.SubscriptionId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksDeliveriesIdRequest generates requests for GetWebhooksDeliveriesId
+func NewGetWebhooksDeliveriesIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deliveries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksDeliveriesIdReplayRequest generates requests for PostWebhooksDeliveriesIdReplay
+func NewPostWebhooksDeliveriesIdReplayRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deliveries/%s/replay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksSubscriptionsRequest generates requests for GetWebhooksSubscriptions
+func NewGetWebhooksSubscriptionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksSubscriptionsRequest calls the generic PostWebhooksSubscriptions builder with application/json body
+func NewPostWebhooksSubscriptionsRequest(server string, body PostWebhooksSubscriptionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksSubscriptionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksSubscriptionsRequestWithBody generates requests for PostWebhooksSubscriptions with any type of body
+func NewPostWebhooksSubscriptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhooksSubscriptionsIdRequest generates requests for DeleteWebhooksSubscriptionsId
+func NewDeleteWebhooksSubscriptionsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksSubscriptionsIdRequest generates requests for GetWebhooksSubscriptionsId
+func NewGetWebhooksSubscriptionsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchWebhooksSubscriptionsIdRequest calls the generic PatchWebhooksSubscriptionsId builder with application/json body
+func NewPatchWebhooksSubscriptionsIdRequest(server string, id string, body PatchWebhooksSubscriptionsIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchWebhooksSubscriptionsIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPatchWebhooksSubscriptionsIdRequestWithBody generates requests for PatchWebhooksSubscriptionsId with any type of body
+func NewPatchWebhooksSubscriptionsIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
+
+	// GetWellKnownOpenidConfigurationWithResponse request
+	GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error)
+
+	// GetAuditEventsWithResponse request
+	GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error)
+
+	// GetAuditEventsExportWithResponse request
+	GetAuditEventsExportWithResponse(ctx context.Context, params *GetAuditEventsExportParams, reqEditors ...RequestEditorFn) (*GetAuditEventsExportResponse, error)
+
+	// PostAuthorizeWithResponse request
+	PostAuthorizeWithResponse(ctx context.Context, params *PostAuthorizeParams, reqEditors ...RequestEditorFn) (*PostAuthorizeResponse, error)
+
+	// PostIntrospectWithBodyWithResponse request with any body
+	PostIntrospectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error)
+
+	PostIntrospectWithFormdataBodyWithResponse(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error)
+
+	// PostRefreshWithBodyWithResponse request with any body
+	PostRefreshWithBodyWithResponse(ctx context.Context, params *PostRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error)
+
+	PostRefreshWithResponse(ctx context.Context, params *PostRefreshParams, body PostRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error)
+
+	// PostRevokeWithBodyWithResponse request with any body
+	PostRevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error)
+
+	PostRevokeWithFormdataBodyWithResponse(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error)
+
+	// GetUserGuidWithResponse request
+	GetUserGuidWithResponse(ctx context.Context, params *GetUserGuidParams, reqEditors ...RequestEditorFn) (*GetUserGuidResponse, error)
+
+	// PostUserLogoutWithResponse request
+	PostUserLogoutWithResponse(ctx context.Context, params *PostUserLogoutParams, reqEditors ...RequestEditorFn) (*PostUserLogoutResponse, error)
+
+	// PostUserLogoutAllWithResponse request
+	PostUserLogoutAllWithResponse(ctx context.Context, params *PostUserLogoutAllParams, reqEditors ...RequestEditorFn) (*PostUserLogoutAllResponse, error)
+
+	// GetUserSessionsWithResponse request
+	GetUserSessionsWithResponse(ctx context.Context, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*GetUserSessionsResponse, error)
+
+	// DeleteUserSessionsIdWithResponse request
+	DeleteUserSessionsIdWithResponse(ctx context.Context, id string, params *DeleteUserSessionsIdParams, reqEditors ...RequestEditorFn) (*DeleteUserSessionsIdResponse, error)
+
+	// GetUserUserinfoWithResponse request
+	GetUserUserinfoWithResponse(ctx context.Context, params *GetUserUserinfoParams, reqEditors ...RequestEditorFn) (*GetUserUserinfoResponse, error)
+
+	// GetWebhooksDeliveriesWithResponse request
+	GetWebhooksDeliveriesWithResponse(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksDeliveriesResponse, error)
+
+	// GetWebhooksDeliveriesIdWithResponse request
+	GetWebhooksDeliveriesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetWebhooksDeliveriesIdResponse, error)
+
+	// PostWebhooksDeliveriesIdReplayWithResponse request
+	PostWebhooksDeliveriesIdReplayWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostWebhooksDeliveriesIdReplayResponse, error)
+
+	// GetWebhooksSubscriptionsWithResponse request
+	GetWebhooksSubscriptionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksSubscriptionsResponse, error)
+
+	// PostWebhooksSubscriptionsWithBodyWithResponse request with any body
+	PostWebhooksSubscriptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksSubscriptionsResponse, error)
+
+	PostWebhooksSubscriptionsWithResponse(ctx context.Context, body PostWebhooksSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksSubscriptionsResponse, error)
+
+	// DeleteWebhooksSubscriptionsIdWithResponse request
+	DeleteWebhooksSubscriptionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteWebhooksSubscriptionsIdResponse, error)
+
+	// GetWebhooksSubscriptionsIdWithResponse request
+	GetWebhooksSubscriptionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetWebhooksSubscriptionsIdResponse, error)
+
+	// PatchWebhooksSubscriptionsIdWithBodyWithResponse request with any body
+	PatchWebhooksSubscriptionsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchWebhooksSubscriptionsIdResponse, error)
+
+	PatchWebhooksSubscriptionsIdWithResponse(ctx context.Context, id string, body PatchWebhooksSubscriptionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchWebhooksSubscriptionsIdResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKS
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownJwksJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWellKnownOpenidConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenIDConfiguration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownOpenidConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownOpenidConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuditEvent
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditEventsExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditEventsExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditEventsExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthorizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokensPair
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostIntrospectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IntrospectionResponse
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostIntrospectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostIntrospectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokensPair
+	JSON400      *N400
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserGuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserGuid
+	JSON400      *N400
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserGuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserGuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUserLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUserLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUserLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUserLogoutAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUserLogoutAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUserLogoutAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Session
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserSessionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *N404
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserSessionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserSessionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserUserinfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserInfo
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserUserinfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserUserinfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDelivery
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksDeliveriesIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDelivery
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksDeliveriesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksDeliveriesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksDeliveriesIdReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryAttempt
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksDeliveriesIdReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksDeliveriesIdReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookSubscription
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookSubscription
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhooksSubscriptionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhooksSubscriptionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhooksSubscriptionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksSubscriptionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscription
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksSubscriptionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksSubscriptionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchWebhooksSubscriptionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscription
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchWebhooksSubscriptionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchWebhooksSubscriptionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
+
+// GetWellKnownOpenidConfigurationWithResponse request returning *GetWellKnownOpenidConfigurationResponse
+func (c *ClientWithResponses) GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error) {
+	rsp, err := c.GetWellKnownOpenidConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownOpenidConfigurationResponse(rsp)
+}
+
+// GetAuditEventsWithResponse request returning *GetAuditEventsResponse
+func (c *ClientWithResponses) GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error) {
+	rsp, err := c.GetAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditEventsResponse(rsp)
+}
+
+// GetAuditEventsExportWithResponse request returning *GetAuditEventsExportResponse
+func (c *ClientWithResponses) GetAuditEventsExportWithResponse(ctx context.Context, params *GetAuditEventsExportParams, reqEditors ...RequestEditorFn) (*GetAuditEventsExportResponse, error) {
+	rsp, err := c.GetAuditEventsExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditEventsExportResponse(rsp)
+}
+
+// PostAuthorizeWithResponse request returning *PostAuthorizeResponse
+func (c *ClientWithResponses) PostAuthorizeWithResponse(ctx context.Context, params *PostAuthorizeParams, reqEditors ...RequestEditorFn) (*PostAuthorizeResponse, error) {
+	rsp, err := c.PostAuthorize(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthorizeResponse(rsp)
+}
+
+// PostIntrospectWithBodyWithResponse request with arbitrary body returning *PostIntrospectResponse
+func (c *ClientWithResponses) PostIntrospectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error) {
+	rsp, err := c.PostIntrospectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntrospectResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostIntrospectWithFormdataBodyWithResponse(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error) {
+	rsp, err := c.PostIntrospectWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntrospectResponse(rsp)
+}
+
+// PostRefreshWithBodyWithResponse request with arbitrary body returning *PostRefreshResponse
+func (c *ClientWithResponses) PostRefreshWithBodyWithResponse(ctx context.Context, params *PostRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error) {
+	rsp, err := c.PostRefreshWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRefreshWithResponse(ctx context.Context, params *PostRefreshParams, body PostRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRefreshResponse, error) {
+	rsp, err := c.PostRefresh(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRefreshResponse(rsp)
+}
+
+// PostRevokeWithBodyWithResponse request with arbitrary body returning *PostRevokeResponse
+func (c *ClientWithResponses) PostRevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error) {
+	rsp, err := c.PostRevokeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRevokeWithFormdataBodyWithResponse(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error) {
+	rsp, err := c.PostRevokeWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeResponse(rsp)
+}
+
+// GetUserGuidWithResponse request returning *GetUserGuidResponse
+func (c *ClientWithResponses) GetUserGuidWithResponse(ctx context.Context, params *GetUserGuidParams, reqEditors ...RequestEditorFn) (*GetUserGuidResponse, error) {
+	rsp, err := c.GetUserGuid(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserGuidResponse(rsp)
+}
+
+// PostUserLogoutWithResponse request returning *PostUserLogoutResponse
+func (c *ClientWithResponses) PostUserLogoutWithResponse(ctx context.Context, params *PostUserLogoutParams, reqEditors ...RequestEditorFn) (*PostUserLogoutResponse, error) {
+	rsp, err := c.PostUserLogout(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUserLogoutResponse(rsp)
+}
+
+// PostUserLogoutAllWithResponse request returning *PostUserLogoutAllResponse
+func (c *ClientWithResponses) PostUserLogoutAllWithResponse(ctx context.Context, params *PostUserLogoutAllParams, reqEditors ...RequestEditorFn) (*PostUserLogoutAllResponse, error) {
+	rsp, err := c.PostUserLogoutAll(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUserLogoutAllResponse(rsp)
+}
+
+// GetUserSessionsWithResponse request returning *GetUserSessionsResponse
+func (c *ClientWithResponses) GetUserSessionsWithResponse(ctx context.Context, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*GetUserSessionsResponse, error) {
+	rsp, err := c.GetUserSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserSessionsResponse(rsp)
+}
+
+// DeleteUserSessionsIdWithResponse request returning *DeleteUserSessionsIdResponse
+func (c *ClientWithResponses) DeleteUserSessionsIdWithResponse(ctx context.Context, id string, params *DeleteUserSessionsIdParams, reqEditors ...RequestEditorFn) (*DeleteUserSessionsIdResponse, error) {
+	rsp, err := c.DeleteUserSessionsId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserSessionsIdResponse(rsp)
+}
+
+// GetUserUserinfoWithResponse request returning *GetUserUserinfoResponse
+func (c *ClientWithResponses) GetUserUserinfoWithResponse(ctx context.Context, params *GetUserUserinfoParams, reqEditors ...RequestEditorFn) (*GetUserUserinfoResponse, error) {
+	rsp, err := c.GetUserUserinfo(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserUserinfoResponse(rsp)
+}
+
+// GetWebhooksDeliveriesWithResponse request returning *GetWebhooksDeliveriesResponse
+func (c *ClientWithResponses) GetWebhooksDeliveriesWithResponse(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksDeliveriesResponse, error) {
+	rsp, err := c.GetWebhooksDeliveries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksDeliveriesResponse(rsp)
+}
+
+// GetWebhooksDeliveriesIdWithResponse request returning *GetWebhooksDeliveriesIdResponse
+func (c *ClientWithResponses) GetWebhooksDeliveriesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetWebhooksDeliveriesIdResponse, error) {
+	rsp, err := c.GetWebhooksDeliveriesId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksDeliveriesIdResponse(rsp)
+}
+
+// PostWebhooksDeliveriesIdReplayWithResponse request returning *PostWebhooksDeliveriesIdReplayResponse
+func (c *ClientWithResponses) PostWebhooksDeliveriesIdReplayWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostWebhooksDeliveriesIdReplayResponse, error) {
+	rsp, err := c.PostWebhooksDeliveriesIdReplay(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksDeliveriesIdReplayResponse(rsp)
+}
+
+// GetWebhooksSubscriptionsWithResponse request returning *GetWebhooksSubscriptionsResponse
+func (c *ClientWithResponses) GetWebhooksSubscriptionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksSubscriptionsResponse, error) {
+	rsp, err := c.GetWebhooksSubscriptions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksSubscriptionsResponse(rsp)
+}
+
+// PostWebhooksSubscriptionsWithBodyWithResponse request with arbitrary body returning *PostWebhooksSubscriptionsResponse
+func (c *ClientWithResponses) PostWebhooksSubscriptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksSubscriptionsResponse, error) {
+	rsp, err := c.PostWebhooksSubscriptionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksSubscriptionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksSubscriptionsWithResponse(ctx context.Context, body PostWebhooksSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksSubscriptionsResponse, error) {
+	rsp, err := c.PostWebhooksSubscriptions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksSubscriptionsResponse(rsp)
+}
+
+// DeleteWebhooksSubscriptionsIdWithResponse request returning *DeleteWebhooksSubscriptionsIdResponse
+func (c *ClientWithResponses) DeleteWebhooksSubscriptionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteWebhooksSubscriptionsIdResponse, error) {
+	rsp, err := c.DeleteWebhooksSubscriptionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhooksSubscriptionsIdResponse(rsp)
+}
+
+// GetWebhooksSubscriptionsIdWithResponse request returning *GetWebhooksSubscriptionsIdResponse
+func (c *ClientWithResponses) GetWebhooksSubscriptionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetWebhooksSubscriptionsIdResponse, error) {
+	rsp, err := c.GetWebhooksSubscriptionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksSubscriptionsIdResponse(rsp)
+}
+
+// PatchWebhooksSubscriptionsIdWithBodyWithResponse request with arbitrary body returning *PatchWebhooksSubscriptionsIdResponse
+func (c *ClientWithResponses) PatchWebhooksSubscriptionsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchWebhooksSubscriptionsIdResponse, error) {
+	rsp, err := c.PatchWebhooksSubscriptionsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchWebhooksSubscriptionsIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchWebhooksSubscriptionsIdWithResponse(ctx context.Context, id string, body PatchWebhooksSubscriptionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchWebhooksSubscriptionsIdResponse, error) {
+	rsp, err := c.PatchWebhooksSubscriptionsId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchWebhooksSubscriptionsIdResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKS
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWellKnownOpenidConfigurationResponse parses an HTTP response from a GetWellKnownOpenidConfigurationWithResponse call
+func ParseGetWellKnownOpenidConfigurationResponse(rsp *http.Response) (*GetWellKnownOpenidConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownOpenidConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OpenIDConfiguration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditEventsResponse parses an HTTP response from a GetAuditEventsWithResponse call
+func ParseGetAuditEventsResponse(rsp *http.Response) (*GetAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditEventsExportResponse parses an HTTP response from a GetAuditEventsExportWithResponse call
+func ParseGetAuditEventsExportResponse(rsp *http.Response) (*GetAuditEventsExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditEventsExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthorizeResponse parses an HTTP response from a PostAuthorizeWithResponse call
+func ParsePostAuthorizeResponse(rsp *http.Response) (*PostAuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokensPair
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostIntrospectResponse parses an HTTP response from a PostIntrospectWithResponse call
+func ParsePostIntrospectResponse(rsp *http.Response) (*PostIntrospectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostIntrospectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IntrospectionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRefreshResponse parses an HTTP response from a PostRefreshWithResponse call
+func ParsePostRefreshResponse(rsp *http.Response) (*PostRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokensPair
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRevokeResponse parses an HTTP response from a PostRevokeWithResponse call
+func ParsePostRevokeResponse(rsp *http.Response) (*PostRevokeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserGuidResponse parses an HTTP response from a GetUserGuidWithResponse call
+func ParseGetUserGuidResponse(rsp *http.Response) (*GetUserGuidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserGuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserGuid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUserLogoutResponse parses an HTTP response from a PostUserLogoutWithResponse call
+func ParsePostUserLogoutResponse(rsp *http.Response) (*PostUserLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUserLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUserLogoutAllResponse parses an HTTP response from a PostUserLogoutAllWithResponse call
+func ParsePostUserLogoutAllResponse(rsp *http.Response) (*PostUserLogoutAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUserLogoutAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserSessionsResponse parses an HTTP response from a GetUserSessionsWithResponse call
+func ParseGetUserSessionsResponse(rsp *http.Response) (*GetUserSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Session
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserSessionsIdResponse parses an HTTP response from a DeleteUserSessionsIdWithResponse call
+func ParseDeleteUserSessionsIdResponse(rsp *http.Response) (*DeleteUserSessionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserSessionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserUserinfoResponse parses an HTTP response from a GetUserUserinfoWithResponse call
+func ParseGetUserUserinfoResponse(rsp *http.Response) (*GetUserUserinfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserUserinfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksDeliveriesResponse parses an HTTP response from a GetWebhooksDeliveriesWithResponse call
+func ParseGetWebhooksDeliveriesResponse(rsp *http.Response) (*GetWebhooksDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksDeliveriesIdResponse parses an HTTP response from a GetWebhooksDeliveriesIdWithResponse call
+func ParseGetWebhooksDeliveriesIdResponse(rsp *http.Response) (*GetWebhooksDeliveriesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksDeliveriesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksDeliveriesIdReplayResponse parses an HTTP response from a PostWebhooksDeliveriesIdReplayWithResponse call
+func ParsePostWebhooksDeliveriesIdReplayResponse(rsp *http.Response) (*PostWebhooksDeliveriesIdReplayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksDeliveriesIdReplayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryAttempt
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksSubscriptionsResponse parses an HTTP response from a GetWebhooksSubscriptionsWithResponse call
+func ParseGetWebhooksSubscriptionsResponse(rsp *http.Response) (*GetWebhooksSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksSubscriptionsResponse parses an HTTP response from a PostWebhooksSubscriptionsWithResponse call
+func ParsePostWebhooksSubscriptionsResponse(rsp *http.Response) (*PostWebhooksSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhooksSubscriptionsIdResponse parses an HTTP response from a DeleteWebhooksSubscriptionsIdWithResponse call
+func ParseDeleteWebhooksSubscriptionsIdResponse(rsp *http.Response) (*DeleteWebhooksSubscriptionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhooksSubscriptionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksSubscriptionsIdResponse parses an HTTP response from a GetWebhooksSubscriptionsIdWithResponse call
+func ParseGetWebhooksSubscriptionsIdResponse(rsp *http.Response) (*GetWebhooksSubscriptionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksSubscriptionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchWebhooksSubscriptionsIdResponse parses an HTTP response from a PatchWebhooksSubscriptionsIdWithResponse call
+func ParsePatchWebhooksSubscriptionsIdResponse(rsp *http.Response) (*PatchWebhooksSubscriptionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchWebhooksSubscriptionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// get public signing keys
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(c *fiber.Ctx) error
+	// get OpenID Connect discovery document
+	// (GET /.well-known/openid-configuration)
+	GetWellKnownOpenidConfiguration(c *fiber.Ctx) error
+	// query audit log
+	// (GET /audit/events)
+	GetAuditEvents(c *fiber.Ctx, params GetAuditEventsParams) error
+	// export audit log
+	// (GET /audit/events/export)
+	GetAuditEventsExport(c *fiber.Ctx, params GetAuditEventsExportParams) error
+	// Authorize user
+	// (POST /authorize)
+	PostAuthorize(c *fiber.Ctx, params PostAuthorizeParams) error
+	// introspect token
+	// (POST /introspect)
+	PostIntrospect(c *fiber.Ctx) error
+	// refresh token
+	// (POST /refresh)
+	PostRefresh(c *fiber.Ctx, params PostRefreshParams) error
+	// revoke token
+	// (POST /revoke)
+	PostRevoke(c *fiber.Ctx) error
+	// get user guid by access token
+	// (GET /user/guid)
+	GetUserGuid(c *fiber.Ctx, params GetUserGuidParams) error
+	// logout by access token
+	// (POST /user/logout)
+	PostUserLogout(c *fiber.Ctx, params PostUserLogoutParams) error
+	// logout from all sessions
+	// (POST /user/logout-all)
+	PostUserLogoutAll(c *fiber.Ctx, params PostUserLogoutAllParams) error
+	// list sessions
+	// (GET /user/sessions)
+	GetUserSessions(c *fiber.Ctx, params GetUserSessionsParams) error
+	// revoke session
+	// (DELETE /user/sessions/{id})
+	DeleteUserSessionsId(c *fiber.Ctx, id string, params DeleteUserSessionsIdParams) error
+	// get OpenID Connect claims of user
+	// (GET /user/userinfo)
+	GetUserUserinfo(c *fiber.Ctx, params GetUserUserinfoParams) error
+	// list webhook deliveries
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(c *fiber.Ctx, params GetWebhooksDeliveriesParams) error
+	// get webhook delivery
+	// (GET /webhooks/deliveries/{id})
+	GetWebhooksDeliveriesId(c *fiber.Ctx, id int64) error
+	// replay webhook delivery
+	// (POST /webhooks/deliveries/{id}/replay)
+	PostWebhooksDeliveriesIdReplay(c *fiber.Ctx, id int64) error
+	// list webhook subscriptions
+	// (GET /webhooks/subscriptions)
+	GetWebhooksSubscriptions(c *fiber.Ctx) error
+	// create webhook subscription
+	// (POST /webhooks/subscriptions)
+	PostWebhooksSubscriptions(c *fiber.Ctx) error
+	// delete webhook subscription
+	// (DELETE /webhooks/subscriptions/{id})
+	DeleteWebhooksSubscriptionsId(c *fiber.Ctx, id string) error
+	// get webhook subscription
+	// (GET /webhooks/subscriptions/{id})
+	GetWebhooksSubscriptionsId(c *fiber.Ctx, id string) error
+	// update webhook subscription
+	// (PATCH /webhooks/subscriptions/{id})
+	PatchWebhooksSubscriptionsId(c *fiber.Ctx, id string) error
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+type MiddlewareFunc fiber.Handler
+
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(c *fiber.Ctx) error {
+
+	return siw.Handler.GetWellKnownJwksJson(c)
+}
+
+// GetWellKnownOpenidConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownOpenidConfiguration(c *fiber.Ctx) error {
+
+	return siw.Handler.GetWellKnownOpenidConfiguration(c)
+}
+
+// GetAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditEvents(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditEventsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "user_guid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_guid", query, &params.UserGuid)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_guid: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", query, &params.Type)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter type: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.GetAuditEvents(c, params)
+}
+
+// GetAuditEventsExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditEventsExport(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditEventsExportParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "user_guid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_guid", query, &params.UserGuid)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_guid: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", query, &params.Type)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter type: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	return siw.Handler.GetAuditEventsExport(c, params)
+}
+
+// PostAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthorize(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(ClientBasicScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuthorizeParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "guid" -------------
+
+	if paramValue := c.Query("guid"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument guid is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "guid", query, &params.Guid)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter guid: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", query, &params.Scope)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter scope: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "nonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nonce", query, &params.Nonce)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter nonce: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "client_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "client_id", query, &params.ClientId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter client_id: %w", err).Error())
+	}
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "User-Agent" -------------
+	if value, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
+		var UserAgent UserAgent
+
+		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", value, &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter User-Agent: %w", err).Error())
+		}
+
+		params.UserAgent = UserAgent
+
+	} else {
+		err = fmt.Errorf("Header parameter User-Agent is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.PostAuthorize(c, params)
+}
+
+// PostIntrospect operation middleware
+func (siw *ServerInterfaceWrapper) PostIntrospect(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(ClientBasicScopes, []string{})
+
+	return siw.Handler.PostIntrospect(c)
+}
+
+// PostRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostRefresh(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostRefreshParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "User-Agent" -------------
+	if value, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
+		var UserAgent UserAgent
+
+		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", value, &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter User-Agent: %w", err).Error())
+		}
+
+		params.UserAgent = UserAgent
+
+	} else {
+		err = fmt.Errorf("Header parameter User-Agent is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.PostRefresh(c, params)
+}
+
+// PostRevoke operation middleware
+func (siw *ServerInterfaceWrapper) PostRevoke(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(ClientBasicScopes, []string{})
+
+	return siw.Handler.PostRevoke(c)
+}
+
+// GetUserGuid operation middleware
+func (siw *ServerInterfaceWrapper) GetUserGuid(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserGuidParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "Authorization" -------------
+	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.GetUserGuid(c, params)
+}
+
+// PostUserLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostUserLogout(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUserLogoutParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "Authorization" -------------
+	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.PostUserLogout(c, params)
+}
+
+// PostUserLogoutAll operation middleware
+func (siw *ServerInterfaceWrapper) PostUserLogoutAll(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUserLogoutAllParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "Authorization" -------------
+	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.PostUserLogoutAll(c, params)
+}
+
+// GetUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSessions(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserSessionsParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "Authorization" -------------
+	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.GetUserSessions(c, params)
+}
+
+// DeleteUserSessionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserSessionsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUserSessionsIdParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "Authorization" -------------
+	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.DeleteUserSessionsId(c, id, params)
+}
+
+// GetUserUserinfo operation middleware
+func (siw *ServerInterfaceWrapper) GetUserUserinfo(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserUserinfoParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "Authorization" -------------
+	if value, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", value, &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Authorization: %w", err).Error())
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err = fmt.Errorf("Header parameter Authorization is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.GetUserUserinfo(c, params)
+}
+
+// GetWebhooksDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeliveries(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeliveriesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "event_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event_type", query, &params.EventType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter event_type: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "subscription_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subscription_id", query, &params.SubscriptionId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter subscription_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.GetWebhooksDeliveries(c, params)
+}
+
+// GetWebhooksDeliveriesId operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeliveriesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	return siw.Handler.GetWebhooksDeliveriesId(c, id)
+}
+
+// PostWebhooksDeliveriesIdReplay operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksDeliveriesIdReplay(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	return siw.Handler.PostWebhooksDeliveriesIdReplay(c, id)
+}
+
+// GetWebhooksSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksSubscriptions(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	return siw.Handler.GetWebhooksSubscriptions(c)
+}
+
+// PostWebhooksSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksSubscriptions(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	return siw.Handler.PostWebhooksSubscriptions(c)
+}
+
+// DeleteWebhooksSubscriptionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksSubscriptionsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	return siw.Handler.DeleteWebhooksSubscriptionsId(c, id)
+}
+
+// GetWebhooksSubscriptionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksSubscriptionsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	return siw.Handler.GetWebhooksSubscriptionsId(c, id)
+}
+
+// PatchWebhooksSubscriptionsId operation middleware
+func (siw *ServerInterfaceWrapper) PatchWebhooksSubscriptionsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(AdminBasicScopes, []string{})
+
+	return siw.Handler.PatchWebhooksSubscriptionsId(c, id)
+}
+
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
+
+	router.Get(options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+
+	router.Get(options.BaseURL+"/.well-known/openid-configuration", wrapper.GetWellKnownOpenidConfiguration)
+
+	router.Get(options.BaseURL+"/audit/events", wrapper.GetAuditEvents)
+
+	router.Get(options.BaseURL+"/audit/events/export", wrapper.GetAuditEventsExport)
+
+	router.Post(options.BaseURL+"/authorize", wrapper.PostAuthorize)
+
+	router.Post(options.BaseURL+"/introspect", wrapper.PostIntrospect)
+
+	router.Post(options.BaseURL+"/refresh", wrapper.PostRefresh)
+
+	router.Post(options.BaseURL+"/revoke", wrapper.PostRevoke)
+
+	router.Get(options.BaseURL+"/user/guid", wrapper.GetUserGuid)
+
+	router.Post(options.BaseURL+"/user/logout", wrapper.PostUserLogout)
+
+	router.Post(options.BaseURL+"/user/logout-all", wrapper.PostUserLogoutAll)
+
+	router.Get(options.BaseURL+"/user/sessions", wrapper.GetUserSessions)
+
+	router.Delete(options.BaseURL+"/user/sessions/:id", wrapper.DeleteUserSessionsId)
+
+	router.Get(options.BaseURL+"/user/userinfo", wrapper.GetUserUserinfo)
+
+	router.Get(options.BaseURL+"/webhooks/deliveries", wrapper.GetWebhooksDeliveries)
+
+	router.Get(options.BaseURL+"/webhooks/deliveries/:id", wrapper.GetWebhooksDeliveriesId)
+
+	router.Post(options.BaseURL+"/webhooks/deliveries/:id/replay", wrapper.PostWebhooksDeliveriesIdReplay)
+
+	router.Get(options.BaseURL+"/webhooks/subscriptions", wrapper.GetWebhooksSubscriptions)
+
+	router.Post(options.BaseURL+"/webhooks/subscriptions", wrapper.PostWebhooksSubscriptions)
+
+	router.Delete(options.BaseURL+"/webhooks/subscriptions/:id", wrapper.DeleteWebhooksSubscriptionsId)
+
+	router.Get(options.BaseURL+"/webhooks/subscriptions/:id", wrapper.GetWebhooksSubscriptionsId)
+
+	router.Patch(options.BaseURL+"/webhooks/subscriptions/:id", wrapper.PatchWebhooksSubscriptionsId)
+
+}
+
+type N400JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type N401JSONResponse struct {
+	Error *string `json:"error,omitempty"`
+}
+
+type N404JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type N409JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type N500JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type GetWellKnownJwksJsonRequestObject struct {
+}
+
+type GetWellKnownJwksJsonResponseObject interface {
+	VisitGetWellKnownJwksJsonResponse(ctx *fiber.Ctx) error
+}
+
+type GetWellKnownJwksJson200JSONResponse JWKS
+
+func (response GetWellKnownJwksJson200JSONResponse) VisitGetWellKnownJwksJsonResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetWellKnownJwksJson500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWellKnownJwksJson500JSONResponse) VisitGetWellKnownJwksJsonResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetWellKnownOpenidConfigurationRequestObject struct {
+}
+
+type GetWellKnownOpenidConfigurationResponseObject interface {
+	VisitGetWellKnownOpenidConfigurationResponse(ctx *fiber.Ctx) error
+}
+
+type GetWellKnownOpenidConfiguration200JSONResponse OpenIDConfiguration
+
+func (response GetWellKnownOpenidConfiguration200JSONResponse) VisitGetWellKnownOpenidConfigurationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetAuditEventsRequestObject struct {
+	Params GetAuditEventsParams
+}
+
+type GetAuditEventsResponseObject interface {
+	VisitGetAuditEventsResponse(ctx *fiber.Ctx) error
+}
+
+type GetAuditEvents200JSONResponse []AuditEvent
+
+func (response GetAuditEvents200JSONResponse) VisitGetAuditEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetAuditEvents400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAuditEvents400JSONResponse) VisitGetAuditEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetAuditEvents401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAuditEvents401JSONResponse) VisitGetAuditEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetAuditEvents500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAuditEvents500JSONResponse) VisitGetAuditEventsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetAuditEventsExportRequestObject struct {
+	Params GetAuditEventsExportParams
+}
+
+type GetAuditEventsExportResponseObject interface {
+	VisitGetAuditEventsExportResponse(ctx *fiber.Ctx) error
+}
+
+type GetAuditEventsExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAuditEventsExport200ApplicationxNdjsonResponse) VisitGetAuditEventsExportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetAuditEventsExport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAuditEventsExport400JSONResponse) VisitGetAuditEventsExportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetAuditEventsExport401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAuditEventsExport401JSONResponse) VisitGetAuditEventsExportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetAuditEventsExport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAuditEventsExport500JSONResponse) VisitGetAuditEventsExportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostAuthorizeRequestObject struct {
+	Params PostAuthorizeParams
+}
+
+type PostAuthorizeResponseObject interface {
+	VisitPostAuthorizeResponse(ctx *fiber.Ctx) error
+}
+
+type PostAuthorize200JSONResponse TokensPair
+
+func (response PostAuthorize200JSONResponse) VisitPostAuthorizeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostAuthorize400JSONResponse struct{ N400JSONResponse }
+
+func (response PostAuthorize400JSONResponse) VisitPostAuthorizeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostAuthorize401JSONResponse struct{ N401JSONResponse }
+
+func (response PostAuthorize401JSONResponse) VisitPostAuthorizeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostAuthorize500JSONResponse struct{ N500JSONResponse }
+
+func (response PostAuthorize500JSONResponse) VisitPostAuthorizeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostIntrospectRequestObject struct {
+	Body *PostIntrospectFormdataRequestBody
+}
+
+type PostIntrospectResponseObject interface {
+	VisitPostIntrospectResponse(ctx *fiber.Ctx) error
+}
+
+type PostIntrospect200JSONResponse IntrospectionResponse
+
+func (response PostIntrospect200JSONResponse) VisitPostIntrospectResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostIntrospect400JSONResponse struct{ N400JSONResponse }
+
+func (response PostIntrospect400JSONResponse) VisitPostIntrospectResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostIntrospect401JSONResponse struct{ N401JSONResponse }
+
+func (response PostIntrospect401JSONResponse) VisitPostIntrospectResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostIntrospect500JSONResponse struct{ N500JSONResponse }
+
+func (response PostIntrospect500JSONResponse) VisitPostIntrospectResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefreshRequestObject struct {
+	Params PostRefreshParams
+	Body   *PostRefreshJSONRequestBody
+}
+
+type PostRefreshResponseObject interface {
+	VisitPostRefreshResponse(ctx *fiber.Ctx) error
+}
+
+type PostRefresh200JSONResponse TokensPair
+
+func (response PostRefresh200JSONResponse) VisitPostRefreshResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefresh400JSONResponse struct{ N400JSONResponse }
+
+func (response PostRefresh400JSONResponse) VisitPostRefreshResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefresh409JSONResponse struct{ N409JSONResponse }
+
+func (response PostRefresh409JSONResponse) VisitPostRefreshResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type PostRefresh500JSONResponse struct{ N500JSONResponse }
+
+func (response PostRefresh500JSONResponse) VisitPostRefreshResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevokeRequestObject struct {
+	Body *PostRevokeFormdataRequestBody
+}
+
+type PostRevokeResponseObject interface {
+	VisitPostRevokeResponse(ctx *fiber.Ctx) error
+}
+
+type PostRevoke200Response struct {
+}
+
+func (response PostRevoke200Response) VisitPostRevokeResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type PostRevoke400JSONResponse struct{ N400JSONResponse }
+
+func (response PostRevoke400JSONResponse) VisitPostRevokeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevoke401JSONResponse struct{ N401JSONResponse }
+
+func (response PostRevoke401JSONResponse) VisitPostRevokeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostRevoke500JSONResponse struct{ N500JSONResponse }
+
+func (response PostRevoke500JSONResponse) VisitPostRevokeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserGuidRequestObject struct {
+	Params GetUserGuidParams
+}
+
+type GetUserGuidResponseObject interface {
+	VisitGetUserGuidResponse(ctx *fiber.Ctx) error
+}
+
+type GetUserGuid200JSONResponse UserGuid
+
+func (response GetUserGuid200JSONResponse) VisitGetUserGuidResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserGuid400JSONResponse struct{ N400JSONResponse }
+
+func (response GetUserGuid400JSONResponse) VisitGetUserGuidResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserGuid500JSONResponse struct{ N500JSONResponse }
+
+func (response GetUserGuid500JSONResponse) VisitGetUserGuidResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostUserLogoutRequestObject struct {
+	Params PostUserLogoutParams
+}
+
+type PostUserLogoutResponseObject interface {
+	VisitPostUserLogoutResponse(ctx *fiber.Ctx) error
+}
+
+type PostUserLogout200Response struct {
+}
+
+func (response PostUserLogout200Response) VisitPostUserLogoutResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type PostUserLogout400JSONResponse struct{ N400JSONResponse }
+
+func (response PostUserLogout400JSONResponse) VisitPostUserLogoutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostUserLogout500JSONResponse struct{ N500JSONResponse }
+
+func (response PostUserLogout500JSONResponse) VisitPostUserLogoutResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostUserLogoutAllRequestObject struct {
+	Params PostUserLogoutAllParams
+}
+
+type PostUserLogoutAllResponseObject interface {
+	VisitPostUserLogoutAllResponse(ctx *fiber.Ctx) error
+}
+
+type PostUserLogoutAll200Response struct {
+}
+
+func (response PostUserLogoutAll200Response) VisitPostUserLogoutAllResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type PostUserLogoutAll500JSONResponse struct{ N500JSONResponse }
+
+func (response PostUserLogoutAll500JSONResponse) VisitPostUserLogoutAllResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserSessionsRequestObject struct {
+	Params GetUserSessionsParams
+}
+
+type GetUserSessionsResponseObject interface {
+	VisitGetUserSessionsResponse(ctx *fiber.Ctx) error
+}
+
+type GetUserSessions200JSONResponse []Session
+
+func (response GetUserSessions200JSONResponse) VisitGetUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserSessions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetUserSessions500JSONResponse) VisitGetUserSessionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUserSessionsIdRequestObject struct {
+	Id     string `json:"id"`
+	Params DeleteUserSessionsIdParams
+}
+
+type DeleteUserSessionsIdResponseObject interface {
+	VisitDeleteUserSessionsIdResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteUserSessionsId200Response struct {
+}
+
+func (response DeleteUserSessionsId200Response) VisitDeleteUserSessionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteUserSessionsId404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteUserSessionsId404JSONResponse) VisitDeleteUserSessionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUserSessionsId500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteUserSessionsId500JSONResponse) VisitDeleteUserSessionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserUserinfoRequestObject struct {
+	Params GetUserUserinfoParams
+}
+
+type GetUserUserinfoResponseObject interface {
+	VisitGetUserUserinfoResponse(ctx *fiber.Ctx) error
+}
+
+type GetUserUserinfo200JSONResponse UserInfo
+
+func (response GetUserUserinfo200JSONResponse) VisitGetUserUserinfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserUserinfo500JSONResponse struct{ N500JSONResponse }
+
+func (response GetUserUserinfo500JSONResponse) VisitGetUserUserinfoResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveriesRequestObject struct {
+	Params GetWebhooksDeliveriesParams
+}
+
+type GetWebhooksDeliveriesResponseObject interface {
+	VisitGetWebhooksDeliveriesResponse(ctx *fiber.Ctx) error
+}
+
+type GetWebhooksDeliveries200JSONResponse []WebhookDelivery
+
+func (response GetWebhooksDeliveries200JSONResponse) VisitGetWebhooksDeliveriesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveries400JSONResponse struct{ N400JSONResponse }
+
+func (response GetWebhooksDeliveries400JSONResponse) VisitGetWebhooksDeliveriesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveries401JSONResponse struct{ N401JSONResponse }
+
+func (response GetWebhooksDeliveries401JSONResponse) VisitGetWebhooksDeliveriesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveries500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWebhooksDeliveries500JSONResponse) VisitGetWebhooksDeliveriesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveriesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetWebhooksDeliveriesIdResponseObject interface {
+	VisitGetWebhooksDeliveriesIdResponse(ctx *fiber.Ctx) error
+}
+
+type GetWebhooksDeliveriesId200JSONResponse WebhookDelivery
+
+func (response GetWebhooksDeliveriesId200JSONResponse) VisitGetWebhooksDeliveriesIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveriesId401JSONResponse struct{ N401JSONResponse }
+
+func (response GetWebhooksDeliveriesId401JSONResponse) VisitGetWebhooksDeliveriesIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveriesId404JSONResponse struct{ N404JSONResponse }
+
+func (response GetWebhooksDeliveriesId404JSONResponse) VisitGetWebhooksDeliveriesIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksDeliveriesId500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWebhooksDeliveriesId500JSONResponse) VisitGetWebhooksDeliveriesIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksDeliveriesIdReplayRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PostWebhooksDeliveriesIdReplayResponseObject interface {
+	VisitPostWebhooksDeliveriesIdReplayResponse(ctx *fiber.Ctx) error
+}
+
+type PostWebhooksDeliveriesIdReplay200JSONResponse WebhookDeliveryAttempt
+
+func (response PostWebhooksDeliveriesIdReplay200JSONResponse) VisitPostWebhooksDeliveriesIdReplayResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksDeliveriesIdReplay401JSONResponse struct{ N401JSONResponse }
+
+func (response PostWebhooksDeliveriesIdReplay401JSONResponse) VisitPostWebhooksDeliveriesIdReplayResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksDeliveriesIdReplay404JSONResponse struct{ N404JSONResponse }
+
+func (response PostWebhooksDeliveriesIdReplay404JSONResponse) VisitPostWebhooksDeliveriesIdReplayResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksDeliveriesIdReplay409JSONResponse struct{ N409JSONResponse }
+
+func (response PostWebhooksDeliveriesIdReplay409JSONResponse) VisitPostWebhooksDeliveriesIdReplayResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksDeliveriesIdReplay500JSONResponse struct{ N500JSONResponse }
+
+func (response PostWebhooksDeliveriesIdReplay500JSONResponse) VisitPostWebhooksDeliveriesIdReplayResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksSubscriptionsRequestObject struct {
+}
+
+type GetWebhooksSubscriptionsResponseObject interface {
+	VisitGetWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error
+}
+
+type GetWebhooksSubscriptions200JSONResponse []WebhookSubscription
+
+func (response GetWebhooksSubscriptions200JSONResponse) VisitGetWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksSubscriptions401JSONResponse struct{ N401JSONResponse }
+
+func (response GetWebhooksSubscriptions401JSONResponse) VisitGetWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksSubscriptions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWebhooksSubscriptions500JSONResponse) VisitGetWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksSubscriptionsRequestObject struct {
+	Body *PostWebhooksSubscriptionsJSONRequestBody
+}
+
+type PostWebhooksSubscriptionsResponseObject interface {
+	VisitPostWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error
+}
+
+type PostWebhooksSubscriptions201JSONResponse WebhookSubscription
+
+func (response PostWebhooksSubscriptions201JSONResponse) VisitPostWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksSubscriptions400JSONResponse struct{ N400JSONResponse }
+
+func (response PostWebhooksSubscriptions400JSONResponse) VisitPostWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksSubscriptions401JSONResponse struct{ N401JSONResponse }
+
+func (response PostWebhooksSubscriptions401JSONResponse) VisitPostWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PostWebhooksSubscriptions500JSONResponse struct{ N500JSONResponse }
+
+func (response PostWebhooksSubscriptions500JSONResponse) VisitPostWebhooksSubscriptionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteWebhooksSubscriptionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteWebhooksSubscriptionsIdResponseObject interface {
+	VisitDeleteWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteWebhooksSubscriptionsId200Response struct {
+}
+
+func (response DeleteWebhooksSubscriptionsId200Response) VisitDeleteWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteWebhooksSubscriptionsId401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteWebhooksSubscriptionsId401JSONResponse) VisitDeleteWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteWebhooksSubscriptionsId404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteWebhooksSubscriptionsId404JSONResponse) VisitDeleteWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteWebhooksSubscriptionsId500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteWebhooksSubscriptionsId500JSONResponse) VisitDeleteWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksSubscriptionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetWebhooksSubscriptionsIdResponseObject interface {
+	VisitGetWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error
+}
+
+type GetWebhooksSubscriptionsId200JSONResponse WebhookSubscription
+
+func (response GetWebhooksSubscriptionsId200JSONResponse) VisitGetWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksSubscriptionsId401JSONResponse struct{ N401JSONResponse }
+
+func (response GetWebhooksSubscriptionsId401JSONResponse) VisitGetWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksSubscriptionsId404JSONResponse struct{ N404JSONResponse }
+
+func (response GetWebhooksSubscriptionsId404JSONResponse) VisitGetWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetWebhooksSubscriptionsId500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWebhooksSubscriptionsId500JSONResponse) VisitGetWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PatchWebhooksSubscriptionsIdRequestObject struct {
+	Id   string `json:"id"`
+	Body *PatchWebhooksSubscriptionsIdJSONRequestBody
+}
+
+type PatchWebhooksSubscriptionsIdResponseObject interface {
+	VisitPatchWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error
+}
+
+type PatchWebhooksSubscriptionsId200JSONResponse WebhookSubscription
+
+func (response PatchWebhooksSubscriptionsId200JSONResponse) VisitPatchWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PatchWebhooksSubscriptionsId400JSONResponse struct{ N400JSONResponse }
+
+func (response PatchWebhooksSubscriptionsId400JSONResponse) VisitPatchWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PatchWebhooksSubscriptionsId401JSONResponse struct{ N401JSONResponse }
+
+func (response PatchWebhooksSubscriptionsId401JSONResponse) VisitPatchWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PatchWebhooksSubscriptionsId404JSONResponse struct{ N404JSONResponse }
+
+func (response PatchWebhooksSubscriptionsId404JSONResponse) VisitPatchWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PatchWebhooksSubscriptionsId500JSONResponse struct{ N500JSONResponse }
+
+func (response PatchWebhooksSubscriptionsId500JSONResponse) VisitPatchWebhooksSubscriptionsIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// get public signing keys
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)
+	// get OpenID Connect discovery document
+	// (GET /.well-known/openid-configuration)
+	GetWellKnownOpenidConfiguration(ctx context.Context, request GetWellKnownOpenidConfigurationRequestObject) (GetWellKnownOpenidConfigurationResponseObject, error)
+	// query audit log
+	// (GET /audit/events)
+	GetAuditEvents(ctx context.Context, request GetAuditEventsRequestObject) (GetAuditEventsResponseObject, error)
+	// export audit log
+	// (GET /audit/events/export)
+	GetAuditEventsExport(ctx context.Context, request GetAuditEventsExportRequestObject) (GetAuditEventsExportResponseObject, error)
+	// Authorize user
+	// (POST /authorize)
+	PostAuthorize(ctx context.Context, request PostAuthorizeRequestObject) (PostAuthorizeResponseObject, error)
+	// introspect token
+	// (POST /introspect)
+	PostIntrospect(ctx context.Context, request PostIntrospectRequestObject) (PostIntrospectResponseObject, error)
+	// refresh token
+	// (POST /refresh)
+	PostRefresh(ctx context.Context, request PostRefreshRequestObject) (PostRefreshResponseObject, error)
+	// revoke token
+	// (POST /revoke)
+	PostRevoke(ctx context.Context, request PostRevokeRequestObject) (PostRevokeResponseObject, error)
+	// get user guid by access token
+	// (GET /user/guid)
+	GetUserGuid(ctx context.Context, request GetUserGuidRequestObject) (GetUserGuidResponseObject, error)
+	// logout by access token
+	// (POST /user/logout)
+	PostUserLogout(ctx context.Context, request PostUserLogoutRequestObject) (PostUserLogoutResponseObject, error)
+	// logout from all sessions
+	// (POST /user/logout-all)
+	PostUserLogoutAll(ctx context.Context, request PostUserLogoutAllRequestObject) (PostUserLogoutAllResponseObject, error)
+	// list sessions
+	// (GET /user/sessions)
+	GetUserSessions(ctx context.Context, request GetUserSessionsRequestObject) (GetUserSessionsResponseObject, error)
+	// revoke session
+	// (DELETE /user/sessions/{id})
+	DeleteUserSessionsId(ctx context.Context, request DeleteUserSessionsIdRequestObject) (DeleteUserSessionsIdResponseObject, error)
+	// get OpenID Connect claims of user
+	// (GET /user/userinfo)
+	GetUserUserinfo(ctx context.Context, request GetUserUserinfoRequestObject) (GetUserUserinfoResponseObject, error)
+	// list webhook deliveries
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(ctx context.Context, request GetWebhooksDeliveriesRequestObject) (GetWebhooksDeliveriesResponseObject, error)
+	// get webhook delivery
+	// (GET /webhooks/deliveries/{id})
+	GetWebhooksDeliveriesId(ctx context.Context, request GetWebhooksDeliveriesIdRequestObject) (GetWebhooksDeliveriesIdResponseObject, error)
+	// replay webhook delivery
+	// (POST /webhooks/deliveries/{id}/replay)
+	PostWebhooksDeliveriesIdReplay(ctx context.Context, request PostWebhooksDeliveriesIdReplayRequestObject) (PostWebhooksDeliveriesIdReplayResponseObject, error)
+	// list webhook subscriptions
+	// (GET /webhooks/subscriptions)
+	GetWebhooksSubscriptions(ctx context.Context, request GetWebhooksSubscriptionsRequestObject) (GetWebhooksSubscriptionsResponseObject, error)
+	// create webhook subscription
+	// (POST /webhooks/subscriptions)
+	PostWebhooksSubscriptions(ctx context.Context, request PostWebhooksSubscriptionsRequestObject) (PostWebhooksSubscriptionsResponseObject, error)
+	// delete webhook subscription
+	// (DELETE /webhooks/subscriptions/{id})
+	DeleteWebhooksSubscriptionsId(ctx context.Context, request DeleteWebhooksSubscriptionsIdRequestObject) (DeleteWebhooksSubscriptionsIdResponseObject, error)
+	// get webhook subscription
+	// (GET /webhooks/subscriptions/{id})
+	GetWebhooksSubscriptionsId(ctx context.Context, request GetWebhooksSubscriptionsIdRequestObject) (GetWebhooksSubscriptionsIdResponseObject, error)
+	// update webhook subscription
+	// (PATCH /webhooks/subscriptions/{id})
+	PatchWebhooksSubscriptionsId(ctx context.Context, request PatchWebhooksSubscriptionsIdRequestObject) (PatchWebhooksSubscriptionsIdResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetWellKnownJwksJson operation middleware
+func (sh *strictHandler) GetWellKnownJwksJson(ctx *fiber.Ctx) error {
+	var request GetWellKnownJwksJsonRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownJwksJson(ctx.UserContext(), request.(GetWellKnownJwksJsonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownJwksJson")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetWellKnownJwksJsonResponseObject); ok {
+		if err := validResponse.VisitGetWellKnownJwksJsonResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetWellKnownOpenidConfiguration operation middleware
+func (sh *strictHandler) GetWellKnownOpenidConfiguration(ctx *fiber.Ctx) error {
+	var request GetWellKnownOpenidConfigurationRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownOpenidConfiguration(ctx.UserContext(), request.(GetWellKnownOpenidConfigurationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownOpenidConfiguration")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetWellKnownOpenidConfigurationResponseObject); ok {
+		if err := validResponse.VisitGetWellKnownOpenidConfigurationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAuditEvents operation middleware
+func (sh *strictHandler) GetAuditEvents(ctx *fiber.Ctx, params GetAuditEventsParams) error {
+	var request GetAuditEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditEvents(ctx.UserContext(), request.(GetAuditEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAuditEventsResponseObject); ok {
+		if err := validResponse.VisitGetAuditEventsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAuditEventsExport operation middleware
+func (sh *strictHandler) GetAuditEventsExport(ctx *fiber.Ctx, params GetAuditEventsExportParams) error {
+	var request GetAuditEventsExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditEventsExport(ctx.UserContext(), request.(GetAuditEventsExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditEventsExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAuditEventsExportResponseObject); ok {
+		if err := validResponse.VisitGetAuditEventsExportResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthorize operation middleware
+func (sh *strictHandler) PostAuthorize(ctx *fiber.Ctx, params PostAuthorizeParams) error {
+	var request PostAuthorizeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthorize(ctx.UserContext(), request.(PostAuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthorize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostAuthorizeResponseObject); ok {
+		if err := validResponse.VisitPostAuthorizeResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostIntrospect operation middleware
+func (sh *strictHandler) PostIntrospect(ctx *fiber.Ctx) error {
+	var request PostIntrospectRequestObject
+
+	var body PostIntrospectFormdataRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntrospect(ctx.UserContext(), request.(PostIntrospectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntrospect")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostIntrospectResponseObject); ok {
+		if err := validResponse.VisitPostIntrospectResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRefresh operation middleware
+func (sh *strictHandler) PostRefresh(ctx *fiber.Ctx, params PostRefreshParams) error {
+	var request PostRefreshRequestObject
+
+	request.Params = params
+
+	var body PostRefreshJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRefresh(ctx.UserContext(), request.(PostRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRefresh")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRefreshResponseObject); ok {
+		if err := validResponse.VisitPostRefreshResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRevoke operation middleware
+func (sh *strictHandler) PostRevoke(ctx *fiber.Ctx) error {
+	var request PostRevokeRequestObject
+
+	var body PostRevokeFormdataRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRevoke(ctx.UserContext(), request.(PostRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRevoke")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostRevokeResponseObject); ok {
+		if err := validResponse.VisitPostRevokeResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUserGuid operation middleware
+func (sh *strictHandler) GetUserGuid(ctx *fiber.Ctx, params GetUserGuidParams) error {
+	var request GetUserGuidRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserGuid(ctx.UserContext(), request.(GetUserGuidRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserGuid")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetUserGuidResponseObject); ok {
+		if err := validResponse.VisitGetUserGuidResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUserLogout operation middleware
+func (sh *strictHandler) PostUserLogout(ctx *fiber.Ctx, params PostUserLogoutParams) error {
+	var request PostUserLogoutRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUserLogout(ctx.UserContext(), request.(PostUserLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUserLogout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostUserLogoutResponseObject); ok {
+		if err := validResponse.VisitPostUserLogoutResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostAuthorize500JSONResponse struct{ N500JSONResponse }
+// PostUserLogoutAll operation middleware
+func (sh *strictHandler) PostUserLogoutAll(ctx *fiber.Ctx, params PostUserLogoutAllParams) error {
+	var request PostUserLogoutAllRequestObject
 
-func (response PostAuthorize500JSONResponse) VisitPostAuthorizeResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	request.Params = params
 
-	return ctx.JSON(&response)
-}
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUserLogoutAll(ctx.UserContext(), request.(PostUserLogoutAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUserLogoutAll")
+	}
 
-type PostRefreshRequestObject struct {
-	Params PostRefreshParams
-	Body   *PostRefreshJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostRefreshResponseObject interface {
-	VisitPostRefreshResponse(ctx *fiber.Ctx) error
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostUserLogoutAllResponseObject); ok {
+		if err := validResponse.VisitPostUserLogoutAllResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostRefresh200JSONResponse TokensPair
-
-func (response PostRefresh200JSONResponse) VisitPostRefreshResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+// GetUserSessions operation middleware
+func (sh *strictHandler) GetUserSessions(ctx *fiber.Ctx, params GetUserSessionsParams) error {
+	var request GetUserSessionsRequestObject
 
-	return ctx.JSON(&response)
-}
+	request.Params = params
 
-type PostRefresh400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserSessions(ctx.UserContext(), request.(GetUserSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserSessions")
+	}
 
-func (response PostRefresh400JSONResponse) VisitPostRefreshResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	response, err := handler(ctx, request)
 
-	return ctx.JSON(&response)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetUserSessionsResponseObject); ok {
+		if err := validResponse.VisitGetUserSessionsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostRefresh500JSONResponse struct{ N500JSONResponse }
+// DeleteUserSessionsId operation middleware
+func (sh *strictHandler) DeleteUserSessionsId(ctx *fiber.Ctx, id string, params DeleteUserSessionsIdParams) error {
+	var request DeleteUserSessionsIdRequestObject
 
-func (response PostRefresh500JSONResponse) VisitPostRefreshResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	request.Id = id
+	request.Params = params
 
-	return ctx.JSON(&response)
-}
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserSessionsId(ctx.UserContext(), request.(DeleteUserSessionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserSessionsId")
+	}
 
-type GetUserGuidRequestObject struct {
-	Params GetUserGuidParams
-}
+	response, err := handler(ctx, request)
 
-type GetUserGuidResponseObject interface {
-	VisitGetUserGuidResponse(ctx *fiber.Ctx) error
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteUserSessionsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteUserSessionsIdResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetUserGuid200JSONResponse UserGuid
-
-func (response GetUserGuid200JSONResponse) VisitGetUserGuidResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+// GetUserUserinfo operation middleware
+func (sh *strictHandler) GetUserUserinfo(ctx *fiber.Ctx, params GetUserUserinfoParams) error {
+	var request GetUserUserinfoRequestObject
 
-	return ctx.JSON(&response)
-}
+	request.Params = params
 
-type GetUserGuid400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserUserinfo(ctx.UserContext(), request.(GetUserUserinfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserUserinfo")
+	}
 
-func (response GetUserGuid400JSONResponse) VisitGetUserGuidResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	response, err := handler(ctx, request)
 
-	return ctx.JSON(&response)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetUserUserinfoResponseObject); ok {
+		if err := validResponse.VisitGetUserUserinfoResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetUserGuid500JSONResponse struct{ N500JSONResponse }
-
-func (response GetUserGuid500JSONResponse) VisitGetUserGuidResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
-
-	return ctx.JSON(&response)
-}
+// GetWebhooksDeliveries operation middleware
+func (sh *strictHandler) GetWebhooksDeliveries(ctx *fiber.Ctx, params GetWebhooksDeliveriesParams) error {
+	var request GetWebhooksDeliveriesRequestObject
 
-type PostUserLogoutRequestObject struct {
-	Params PostUserLogoutParams
-}
+	request.Params = params
 
-type PostUserLogoutResponseObject interface {
-	VisitPostUserLogoutResponse(ctx *fiber.Ctx) error
-}
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooksDeliveries(ctx.UserContext(), request.(GetWebhooksDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooksDeliveries")
+	}
 
-type PostUserLogout200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PostUserLogout200Response) VisitPostUserLogoutResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetWebhooksDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksDeliveriesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PostUserLogout400JSONResponse struct{ N400JSONResponse }
-
-func (response PostUserLogout400JSONResponse) VisitPostUserLogoutResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+// GetWebhooksDeliveriesId operation middleware
+func (sh *strictHandler) GetWebhooksDeliveriesId(ctx *fiber.Ctx, id int64) error {
+	var request GetWebhooksDeliveriesIdRequestObject
 
-	return ctx.JSON(&response)
-}
+	request.Id = id
 
-type PostUserLogout500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooksDeliveriesId(ctx.UserContext(), request.(GetWebhooksDeliveriesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooksDeliveriesId")
+	}
 
-func (response PostUserLogout500JSONResponse) VisitPostUserLogoutResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	response, err := handler(ctx, request)
 
-	return ctx.JSON(&response)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetWebhooksDeliveriesIdResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksDeliveriesIdResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Authorize user
-	// (POST /authorize)
-	PostAuthorize(ctx context.Context, request PostAuthorizeRequestObject) (PostAuthorizeResponseObject, error)
-	// refresh token
-	// (POST /refresh)
-	PostRefresh(ctx context.Context, request PostRefreshRequestObject) (PostRefreshResponseObject, error)
-	// get user guid by access token
-	// (GET /user/guid)
-	GetUserGuid(ctx context.Context, request GetUserGuidRequestObject) (GetUserGuidResponseObject, error)
-	// logout by access token
-	// (POST /user/logout)
-	PostUserLogout(ctx context.Context, request PostUserLogoutRequestObject) (PostUserLogoutResponseObject, error)
-}
+// PostWebhooksDeliveriesIdReplay operation middleware
+func (sh *strictHandler) PostWebhooksDeliveriesIdReplay(ctx *fiber.Ctx, id int64) error {
+	var request PostWebhooksDeliveriesIdReplayRequestObject
 
-type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+	request.Id = id
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksDeliveriesIdReplay(ctx.UserContext(), request.(PostWebhooksDeliveriesIdReplayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksDeliveriesIdReplay")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PostWebhooksDeliveriesIdReplayResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksDeliveriesIdReplayResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// PostAuthorize operation middleware
-func (sh *strictHandler) PostAuthorize(ctx *fiber.Ctx, params PostAuthorizeParams) error {
-	var request PostAuthorizeRequestObject
-
-	request.Params = params
+// GetWebhooksSubscriptions operation middleware
+func (sh *strictHandler) GetWebhooksSubscriptions(ctx *fiber.Ctx) error {
+	var request GetWebhooksSubscriptionsRequestObject
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthorize(ctx.UserContext(), request.(PostAuthorizeRequestObject))
+		return sh.ssi.GetWebhooksSubscriptions(ctx.UserContext(), request.(GetWebhooksSubscriptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthorize")
+		handler = middleware(handler, "GetWebhooksSubscriptions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(PostAuthorizeResponseObject); ok {
-		if err := validResponse.VisitPostAuthorizeResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksSubscriptionsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1156,31 +5989,29 @@ func (sh *strictHandler) PostAuthorize(ctx *fiber.Ctx, params PostAuthorizeParam
 	return nil
 }
 
-// PostRefresh operation middleware
-func (sh *strictHandler) PostRefresh(ctx *fiber.Ctx, params PostRefreshParams) error {
-	var request PostRefreshRequestObject
-
-	request.Params = params
+// PostWebhooksSubscriptions operation middleware
+func (sh *strictHandler) PostWebhooksSubscriptions(ctx *fiber.Ctx) error {
+	var request PostWebhooksSubscriptionsRequestObject
 
-	var body PostRefreshJSONRequestBody
+	var body PostWebhooksSubscriptionsJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRefresh(ctx.UserContext(), request.(PostRefreshRequestObject))
+		return sh.ssi.PostWebhooksSubscriptions(ctx.UserContext(), request.(PostWebhooksSubscriptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRefresh")
+		handler = middleware(handler, "PostWebhooksSubscriptions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(PostRefreshResponseObject); ok {
-		if err := validResponse.VisitPostRefreshResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksSubscriptionsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1189,25 +6020,25 @@ func (sh *strictHandler) PostRefresh(ctx *fiber.Ctx, params PostRefreshParams) e
 	return nil
 }
 
-// GetUserGuid operation middleware
-func (sh *strictHandler) GetUserGuid(ctx *fiber.Ctx, params GetUserGuidParams) error {
-	var request GetUserGuidRequestObject
+// DeleteWebhooksSubscriptionsId operation middleware
+func (sh *strictHandler) DeleteWebhooksSubscriptionsId(ctx *fiber.Ctx, id string) error {
+	var request DeleteWebhooksSubscriptionsIdRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUserGuid(ctx.UserContext(), request.(GetUserGuidRequestObject))
+		return sh.ssi.DeleteWebhooksSubscriptionsId(ctx.UserContext(), request.(DeleteWebhooksSubscriptionsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUserGuid")
+		handler = middleware(handler, "DeleteWebhooksSubscriptionsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetUserGuidResponseObject); ok {
-		if err := validResponse.VisitGetUserGuidResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhooksSubscriptionsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhooksSubscriptionsIdResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1216,25 +6047,58 @@ func (sh *strictHandler) GetUserGuid(ctx *fiber.Ctx, params GetUserGuidParams) e
 	return nil
 }
 
-// PostUserLogout operation middleware
-func (sh *strictHandler) PostUserLogout(ctx *fiber.Ctx, params PostUserLogoutParams) error {
-	var request PostUserLogoutRequestObject
+// GetWebhooksSubscriptionsId operation middleware
+func (sh *strictHandler) GetWebhooksSubscriptionsId(ctx *fiber.Ctx, id string) error {
+	var request GetWebhooksSubscriptionsIdRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUserLogout(ctx.UserContext(), request.(PostUserLogoutRequestObject))
+		return sh.ssi.GetWebhooksSubscriptionsId(ctx.UserContext(), request.(GetWebhooksSubscriptionsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUserLogout")
+		handler = middleware(handler, "GetWebhooksSubscriptionsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(PostUserLogoutResponseObject); ok {
-		if err := validResponse.VisitPostUserLogoutResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksSubscriptionsIdResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksSubscriptionsIdResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchWebhooksSubscriptionsId operation middleware
+func (sh *strictHandler) PatchWebhooksSubscriptionsId(ctx *fiber.Ctx, id string) error {
+	var request PatchWebhooksSubscriptionsIdRequestObject
+
+	request.Id = id
+
+	var body PatchWebhooksSubscriptionsIdJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchWebhooksSubscriptionsId(ctx.UserContext(), request.(PatchWebhooksSubscriptionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchWebhooksSubscriptionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PatchWebhooksSubscriptionsIdResponseObject); ok {
+		if err := validResponse.VisitPatchWebhooksSubscriptionsIdResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1246,26 +6110,86 @@ func (sh *strictHandler) PostUserLogout(ctx *fiber.Ctx, params PostUserLogoutPar
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xW2ZLaOBT9FUozjyy2WRJ4M0szIpZpwG4ap1IpYwsjg5dIchuT4t+nZNwQAj3JzFSW",
-	"h35i0dG9V/eec6TPwImCOApxyBnofAaxTe0Ac0zzXybDVPVwyMUPEoIOWGPbxRSUQWgHGHRyROUIKQOK",
-	"PyWEYhd0OE1wGTBnjQNb7MU7O4i3YsN9xHhgh9Mk5CTAtTfVRqMqgzLgWSyWGack9MDhUM5DDxPi3ouS",
-	"TgV8SjDNzvm9hLjfmXnVWjZWNpYrzUa7VWnU228ry1W9VZGU+lulKbXfrFzlRiEHEZ3FUchw3pOGJIkP",
-	"Jwp50Rg7jrfEsTmJwprPolD8dy4gplGMKSfH3QFmzPaw+Hp94uKfaOljhx9Tu5g5lMQiNuiAru2WxFEx",
-	"4+BQBs1fWAoMOaahvS3NMH3CtDSgNKL51mO+PIURbXDI7m1Cr9PbjoMZ+8gF5HJQOButl0OHjMkImntz",
-	"hwhkMJw2nR5swU38+NAbtas4G8VOHQkQt+bTJ+svfeUOH/ZuT4B1eUFgCwYLZeFPg8Xc3GmGKY2NBdf7",
-	"qDnuycTykaIZaob2XqYbalNXrE2eKLiTLZEo2D0tlDtmD9t7tx81UN9LIUmJ9bhOoR/t9L2Z6YYn6/5g",
-	"p/VGySJYkLE/qOsGlFF/kCJjwGCwXbs92EKGIyN/0hwbcIdmKbHDaQz9iMy2rjkzJ0TrjTTrcUPGBEq6",
-	"MVHGfdhYZJKChlDWjMHO8lU+nj/4FpFS1B/I+nwqTlbX+5CsJtW6pbX6DX0UsjcPqlqfzQfmtDsxKrqe",
-	"uG5dq2gT7KfqZr98pPfK23ftO1N6erQ2qJ5hfZkYeurMu+42sELU7Pms/fEdU7oV9VoKQggritn6haGZ",
-	"9lzeinMhX830mZTqmbTTe+pO96MM9aMdmjUUtFdl1EdN2GMp2qspHMjGLOvy+xlraL6Z6T5sor2Xohlk",
-	"cMO3eAZb0J/Ien/RQP7a14xFuvBNjoy7APWkpjXXA81QU2SYsjW0fMt3JERG7ZuWcraJ95f8+/poH64U",
-	"cPajayonDNOPXrH0vw3npvhIuIpypRKex1ZjAsrgCVN2lKNclaqSqDKKcWjHBHRAvSpV66AMYpuv8zJr",
-	"dsLXESX7XPNxxHLLuJS1+gwpiUOV7FAYDk9oWMobw0CegeYOA93Cz0+b8mznC+T9Z/AnxSvQAX/UztdM",
-	"7QypXXr8ofxdG47XzeHDV8as/Es3vJWp8K7aF8Z1w/tEESWW5ARaJdttVjp11hUzKK6IWwlOFdcE6Ozh",
-	"/4wVoNxakyCwaXY1J8Ei2xMdz7kIPghwraD0y8MuAMVovxx2iNPSM7luTXxahP4v8/5yfPlV1o3c7AdN",
-	"7vDLOJKvXpKkaPdP48jFeG9TRHytPTuXh29wxMP8aAUCVVpmpaNvnoJecmOI+ckmr7hx8wH5TOQ8xne+",
-	"5F4fCL/nA+GHWvKJV7+n2L6lk5fEt428KOEve/Rx/ZvCE6YsOqQdw71q71V7Rx5fsulCIgX1fo4+XuTx",
-	"18I4HP4OAAD//0DWp++UEAAA",
+	"H4sIAAAAAAAC/+x8aW/bONfoXyF0L3CfC8hbtjYB3g9pkmmdZmnjZNJmUhi0dGwzkUgNScV2B/nvL0hq",
+	"tShHbpouD+ZLG5vLOTwbz0b/43gsjBgFKoWz948TYY5DkMD1pysBfH8CVKoPhDp7zhSwD9xxHYpDcPb0",
+	"jJaZ4joc/o4JB9/ZkzwG1xHeFEKs1sIch1GgFnxgQoaYXsRUkhA6r9pbW+2e4zpyEalhITmhE+fx0dVb",
+	"v42J/0GhlCHwdwx8kcOfxMRvCHm8M9oaY+i1trd2d1pbm7uvW6Px5k6ru7H5emO7u/tq7G9YEHlUu4uI",
+	"UQGaJlvdrvrPY1QmhMFRFBAPS8Jo504wqr7LEYg4i4BLYlaHIASegPqzeuLkGza6A08a0D4Ij5NI7e3s",
+	"OW+wj9RRQUjn0XW2ur1noAKcM/6NiFxRHMsp4+Qr+AaTrZ9GlDMm0ZjFNEFk96chcsDoOCCeZs32T5SS",
+	"PpXAKQ7QAPgDcHSkGa3mGXgaxH7sE3n0kCBXBk989e+Y8RBLZ88hVO5s5ZpBqIQJcHVKEllQdB3meTHn",
+	"4A+xLG3kYwktpfZVNXMdFkuPhfrMQOPQ2fvLEbHngRCO64wxCWIOzhfLQg44IWeZDB6OBSA2RslixDji",
+	"8MAMD2woCBCCMDo0568Mmy/+cf4vh7Gz5/yfTm46OwllOzlZL9XsR9eJBfAhTo1oZVM9rK2Yldm5YfvL",
+	"0ZauSNsEpZx2xe1Kx9GsKuGS0e1LRaJcZ+kUBZZkaq83GHMQ09K+w5CIEEtPfSvZPdAhh1io2QGbsFgW",
+	"0FK8uAe/MNF8tvG4TyVnIgJPse4isYAVudX7VOnoOvMWwxFpecyHCdAWzCXHLYknIhVPZy9ZrBVMo6P2",
+	"GE4JlaXja4EcmrkZBZLPX9xvB1wA97jM9+Xdcz4tkcXcUlW6YE+Sh6I1GTEWAKZqCxxrySMSQrFC6h3M",
+	"OV6oz15AFJ9rdATmUVPbgWXTmVFVuUmEsO9zEEJpeCJSNp0mwn6sO0ms3wviW6D5T0AR8chOvIy31U3f",
+	"AObA0ZhxZMQK6dkuIhIRgfBIAJV6OBEzlIpd7tOYPWwYlc1OGbIaQ3ps5bGWxDCRIpscHl+/t0hdMCk7",
+	"YBeDje0dG64ef7ALU3n9/sf9N7bl98QvT+yOd2HDe4Vb26Oe39qC1+PWLt70Wjv+xqgH3fEWfuVZN5KL",
+	"ZYz3bfNonSUvrxZkYls9t65ePG3/FXrmtAaYq0lcw49BlSH3sBAlXV91kSmWVrR/GSG1oQ3+eQS0f6j8",
+	"ITKJublvq/KRXCV6eAjUjxipuSS9AJNQDEUcRYxLWNNiTTimUivh0hZlrYAwkgsXZTccwtTPVC9FTyDM",
+	"AXmxkCzUE4hidqhUiTKq3Q0hMfUx95GGKxx3DVSJb+6SoSATSuhkiIPJ8AEH8WrUsViEIUhOPISDCeNE",
+	"TkNtGBWHUBSPAiKm4CNC0d3sXrgon69nqDNRUL4i9h8UhwT46+EtRAy8LP5TKaO9TidgHg6mTMi9193X",
+	"XZtCKJSGMbfb4zT2+gb+pTxT1tTGMVFlWQoNaWhrkUB4rILgGqtjrTy2UzbfxIjOSk1Spp/QMVs1a9nt",
+	"NLx16xS2AtcGpcBlC6lWsLlOfetJ1lSLLGbFZssuspDhX78zpckgcRkqlPA4YLlm4KfjGZubkjgmSkGL",
+	"DhKKBfiJX2QY4lr82u/lE5CovE9v41W72+7aclauE2Ahhwlz1qRC2V/7lnxZJVQsMMOGmi0mTHlhY/ql",
+	"Ir74gAm3RRgF+SzhD4vj6eitR87Jcf/q69X8lPRFn15sewf9nf599OnPg+PdNiyOI2/zVE2SN9cXDzfv",
+	"zsb+2z+/+gdq8lnvM+nv9MPPG5/vLsLP11fzk8ur7vnlZ3l2eLp9ftAjN3enGyeX+4vTr5PF2eX+9tnG",
+	"zb0GFP7Ru1GAwvnD540/BH67+9U/ZFunh5NZn8zIzafprH/H5mdfrxZnl5Pe2d3R/OTgOP4cfibnd0eb",
+	"Z5f93unh0ez08kj0w2DqH/R3Ti+93undx+3zy/78dDAjmF5E/TtGBoF/Nbj6SE4Ojk9uPt2Tc9Lvnl1+",
+	"3Dg/7G99XnQ3Tt/2eyeXR/Obu315fv3n3Q3pzk4Pj3pn1xfqZJtnh30y/tjevDnZOdw6O6bi1Z/7+5uD",
+	"66OrizcfL1tnZ7Hvb560Tj7C3Wz//uvoE/+w8fr97h9X3YdPN/enmws4G8WXZzPv+o0fhDf0dPvgTuwO",
+	"34uNNy2rFwvziHAQQ2LJnQRkDEreKrpHKBLgMeqLYhzyemer27WGbn4uE2UIxkVEB4xS8CQifhr9cJAx",
+	"p+Cj0QJ18st8NgWK9MWBPEYlJlQgFgHVkm7xGoyBbHrGUoRVOGQajAUkJNLghEeCBbEEVFyfx1AZTXa6",
+	"W6/tRClb7wpeGQ7GcCABAXiS8fYDcDImwItQnL83b0Y74af7i434cy866Xpnr2eXk/a7u63Fn7viI7zf",
+	"4Kev5vu90cG2/8fryXH3/mSTftj5e7ArrzZmn7a+HjUI/BpdPrmlSDP3VTtRynY9OzVfA7pPx6wKOgnR",
+	"vwPQIm3UrjYSXMNoytj9IQTkAfjCYjGlVA7rMGATiz9vBrUL7ydbFDSD0WChL0BGIRt33GaB3RJm+waU",
+	"zZ1MsajiR+NwBFwbhxTTEPug1MMnIlL5P+AK4SjAJryg/08ij8XUOF5VnfgWxyE5+Zqr4GFV+koPNkn0",
+	"JmQspXob5831ZVxXf3EdCnM5TAVknbNFeBEwrLHAvk8Ut3DwoSB3pjpWZuaI+QvFypk5kWORZiGxjNeV",
+	"rIFZZIKbDOCwcaZ7eVWBdSVG5cfOEC3IbpWaJfKXJK+BIqfq8l0833oJWEOSJFBvMQxFwwVGJ2tNDprh",
+	"XJeTuTb3OnG9h6YeLOolrj5szWmcBX+5nJWRM9+bi9pMdVHXuATpFwpxZWQ4eEAeaqxMzIOGwqdmVk9Z",
+	"RbXEgZSjGZXXla5Bdv408IuA+iaky6yd/hvb6yQVq1TYKvFR2glK2v9ve1NMJ3kRpq2rNUMfJHhmTrpq",
+	"VXUmgTooKOx6RYhvUp7MAjRPatqMdjUF930CRwEeB0tUew/a2qrUBJYxB7F8sTOKND1qSg2JDJfzbGKv",
+	"02FyCrwtgD8QD9oq49YR4MWcyEWzeNHIfJGubsq1poJcFIEDvaIqCC/FuIQwZWpr4qCYB4rmOhHqIUUa",
+	"F0UBJhSpcZMVTIvmatRkRHEQsFnKldECXR+9eXd+/n64f3Jyfj3snw2ODq4ujoaX+xdvjy4HJa/8e/Ck",
+	"yo6GhL+KfCvhV2ngCzPl6S4G10kJM1DbJxj7IaFvsCBelbMeBx+oJDjQF4OeSoTkWDLuIlOlRMVJiReq",
+	"opkosW0akiKEBpEhpbiXlzrr4Kd1UC0+ySej9OaimhAhlb1Oxp6Ep2hAktBFEmlKXhFxXOcBuEm3OT2V",
+	"e1KosQgojoiz52y2u+1N7QTJqSZapz2DIGjdUzajHZV1baftJhObPZqARMeD8zN0DSP0HhZoABLNiJym",
+	"6qIrBCraMPGnyYUuJwaEo3EytZ6+7+w5b0FeQxC8V2gcz+7FsWDUWeql2lizS+aJgtXA1g2zfLZCd45t",
+	"uwy/jpqk5TIOQ8wXCakSoiSZZU0cxUidWv3LySnvfFFrS7ww6YqWt1wWq2XLUo4k4uyB+MBRCBL7WOKV",
+	"ND/X0Mo1uBckv63kZ+HGk0eqUnxpiU+Ex5SzhHzmxaHRrFr6Y9XJ0tHWTdTSOiBCotT8IDPZRXIKiMIM",
+	"hEQThsaEC2kjeN4rIxy31D/5l71lsdSik9G2YiHti5OIpxlPlpuRHt3lkwuJuTZXOpHFlS/oIkK9IBbm",
+	"2rfhMOYsLOHQxGGrwgbqL0OG+WrIkn0HuEKlE5U3gBXIXrer0xYwxnEgtS3vdbtdhCUKmZA1iOh8YAmX",
+	"EM9JqBxttdp11GVkPlbjkDresvFYwNKu6TaWROLjl2eqc6NLPpchSztARb317ESDTDtmA0OrJuXdrE/N",
+	"7a1vwFOPS2lk0aX468vjl6K10fxA2mQglZfL7Yr+zmJSOjCPGJe1lsUMK08S6a448JftDMLCXL8nhIJQ",
+	"aV/GlUGUUxUnJM1+rs705axAEXAUEApPGKQjg92/ZunXM0vrKe+8Rf2qAlta1evV0ciQ2gQZpzsXot9O",
+	"UVO1elJT02ZVFQsxYVHQ/XQKMk1x1E+i8cStbSNT9tQB4Uh1mSPJ0uhCB4Y6C0Wk7jsBKhXHQKgr5d3l",
+	"5QekD1AMQ9xbqoPCGRFglFztrHssfO12s1gm+9/SinqravB+oQV3Sa9tZM6ndMovKh7dRgvM4w6LjkbY",
+	"AyRATVelMdPU4WaVPFU2yxIb9dW7Nhror80ns+hOJ5/MIhO0Fv1tNSfrX6rRSA3Ksb8DqasaVo+oW0YU",
+	"OI9FBDTnKaMeIN04oqxEetgaNPTsp+ypLaxEsynxpmmDQxpjuUhIpiJKLFAefhpklOTqxggstF6AwrOA",
+	"YfuW9iUKYyHNTZTKcFFs/Xqxva07YobHymN+ecHIo9CXYHupotQ6eUgwjoNggZZfrvxihk+JRCnxsGz8",
+	"yiarYPr0R2P5SNYYXm/6EjsnJJbFOv9yv7ESKLNwZDoIL/44QK92djZc5OEgAG6EqihHuXy2b+lSm5Ex",
+	"mj6MtbtjxFwN6io7UylmFEcm2qqxf3nTe56hf8P8xcrrczabtdS93Ip5ANRjvumtayZh1tcHS+1Sksfw",
+	"+IJSbm/1twj8IOVn0lj2K97tK+U7l93MtqYSzpSUJSKeCOkq+S5IsShe7RRmKM2p2STsInvYsv79mlyX",
+	"X5qK5nNs3eNPs6p6tGxWs96y9UVut8nc3Wck7pYfUFgspqlvrZImNb6ekex2d10UU52PysRQeXsTqq7x",
+	"9i3Nm0urXUgGokBEirS/yORlS3nX1HUkyglt39JzZV7LQ5It3fJpchxTNIIEju8mYNkYaQc1naU9srF2",
+	"Lf6z1e3+/9s6ndH0+0EWudqU29wc27qtEhoovib8+g0NZyKiq4ymEvhO2n9Vm3dWs5CapfvdCtJmSzdk",
+	"zV72LMPyg/H9YhN5w5fb//aQ/po9pC/q1mdy9cLXzzNqQav0xHLFqD87yTPY2nvGjD+peMrkKgqdpK9q",
+	"/9W9f3WvKk0lFUlE78foR60cr1aMFg6Cp52wIMjyQsYx0i0ASTi8SlH2g+C768o3MUYdIcM97a16NrVV",
+	"ChwVt15B72zKyqpoUzInjsAgh/tTiPx9a1/JaRoVvsp0eg4rTTG6Mf86/xD/0XAvAAm1SpM8dWzK0EO9",
+	"W5Gnff/FuZpWl1QvS74f8dfapJlhTAKqguolv2HzlFHcelYcqllReHFfw9z0AeU67SHmNWPK0Kau+1UK",
+	"6VfX2Kd8Rf3qw/arPCWyPNPjW0lyOz+TtnrRSRp4k2a8epObz1MbJ1ViyVCxH755e0rSpCcOc+iNysGl",
+	"BvtmXKj2A9aVi7Pm6bU2Xn5YULt75eHAGvWXcnfIdqU5ZPs5vSHb/1WtIUtsaXJNJksKMv77FZ+1ks6q",
+	"Byl2oelBUW8Bsku71rgncxcm5RewiS7mSYEKL1saKHvtpb3WFfvku5IXte4VOXtSrhbrSsrL3f1NpUrx",
+	"fLZ8irVFqpM/77FHUAKon8uWZPmPZCTiVTSeiJPJVCLKZi6C9qSN8FgCR8S8ElKPUFoBSN3k3L6lgyzW",
+	"TB4OoRDze5FDw9nfKtusfpVNN9fr0p+uCgZsMtFbfTCvXgpKoOuERKARqO/1LyMxjmaYSNObzEGqJ5K4",
+	"eDrzeBKkyesSmrq+hRPe0qQr3GBscty7dTlum3pdpC99/ruULHsWagl1zNDSKzH9UjqpKBjWvqQOvlzN",
+	"qKm+JiL+DSpb8uNWu4KFRwQpoFRfhYvMY4Pk13uYzLptVt0MgxLwH+glFAGv4ymUqfXrOQBiiaAW7ru1",
+	"2SzD3dwGK0uWOPxsjCbkAaj5JaI2Skv+ef9U/takiEP6iwXLb8o8aN/SI2NME9SJ0G1V4O8hE+ahT62E",
+	"7K1B+jxNzbp1Hnr/c+to6zqFuQL57nT/oDV4t7+xvaM+3jqShCAkDqO2ek9869zSe1iYjiKDqKv6uTig",
+	"bKLa2XRYsXEVgcts2n9iSubpDzI8ZZqr8v39i/D1b90aFSF7L4mINQLWyPnlq127laa0rLjz+7nh5kGi",
+	"VQ/XNcINUmghe4CKngkU08yjSTHJ7bH2UjBdhIxDTX7NKrZ932mW2y+wM3F0fj+/1yC+Bhvd9L5sdsfV",
+	"EvNHaqDtMvu9Q5QmfHq2R2xJIerfFqnqgnnGrt75uuYCNdem8vuz/say9rKQSGUUxwQC3+hsAGOpghRS",
+	"ja0/KLCr5OuH3DLJw94f3HnYUMYNcr5Fxl/mVvn5+hDrE69zAz0+/u8AwmHkoXBhAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,9 +1,11 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
 	"go.dataddo.com/env"
 	"log"
+	"strings"
 )
 
 type DBConfig struct {
@@ -12,6 +14,11 @@ type DBConfig struct {
 	User     string `env:"USER"`
 	Password string `env:"PASSWORD"`
 	DB       string `env:"DB"`
+	// IsolationLevel isolation level of transactions: read committed, repeatable read or serializable.
+	// Default level of database is used when empty
+	IsolationLevel string `env:"ISOLATION_LEVEL"`
+	// AutoMigrate apply pending migrations on startup of server
+	AutoMigrate bool `env:"AUTO_MIGRATE"`
 }
 
 func NewConfig() (*DBConfig, error) {
@@ -22,3 +29,32 @@ func NewConfig() (*DBConfig, error) {
 	log.Printf("db config was loaded successfully")
 	return &config, nil
 }
+
+// DSN return connection string of database
+func (c *DBConfig) DSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		c.Host,
+		c.Port,
+		c.User,
+		c.Password,
+		c.DB,
+	)
+}
+
+// TxOptions return options of transactions built from config
+func (c *DBConfig) TxOptions() (*sql.TxOptions, error) {
+	var level sql.IsolationLevel
+	switch strings.ToLower(strings.TrimSpace(c.IsolationLevel)) {
+	case "":
+		return nil, nil
+	case "read committed":
+		level = sql.LevelReadCommitted
+	case "repeatable read":
+		level = sql.LevelRepeatableRead
+	case "serializable":
+		level = sql.LevelSerializable
+	default:
+		return nil, fmt.Errorf("unsupported isolation level %q", c.IsolationLevel)
+	}
+	return &sql.TxOptions{Isolation: level}, nil
+}
@@ -11,14 +11,7 @@ type Database struct {
 }
 
 func NewDatabase(config *DBConfig) (*Database, error) {
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		config.Host,
-		config.Port,
-		config.User,
-		config.Password,
-		config.DB,
-	)
-	db, err := sqlx.Connect("postgres", dsn)
+	db, err := sqlx.Connect("postgres", config.DSN())
 	if err != nil {
 		return nil, fmt.Errorf("database connect: %w", err)
 	}
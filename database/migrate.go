@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+	"medods/migrations"
+)
+
+// Migrator apply embedded migrations. Every operation holds session advisory lock,
+// so replicas started in parallel don't race
+type Migrator struct {
+	provider *goose.Provider
+}
+
+func NewMigrator(db *Database) (*Migrator, error) {
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("create migrations locker: %w", err)
+	}
+	provider, err := goose.NewProvider(goose.DialectPostgres, db.DB.DB, migrations.FS, goose.WithSessionLocker(locker))
+	if err != nil {
+		return nil, fmt.Errorf("create migrations provider: %w", err)
+	}
+	return &Migrator{provider: provider}, nil
+}
+
+// Up apply all pending migrations
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	res, err := m.provider.Up(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate up: %w", err)
+	}
+	return res, nil
+}
+
+// Down roll back the last applied migration
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	res, err := m.provider.Down(ctx)
+	if errors.Is(err, goose.ErrNoNextVersion) {
+		return nil, errors.New("migrate down: no applied migrations")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("migrate down: %w", err)
+	}
+	return res, nil
+}
+
+// Redo roll back the last applied migration and apply it again
+func (m *Migrator) Redo(ctx context.Context) ([]*goose.MigrationResult, error) {
+	down, err := m.Down(ctx)
+	if err != nil {
+		return nil, err
+	}
+	up, err := m.provider.UpByOne(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate up: %w", err)
+	}
+	return []*goose.MigrationResult{down, up}, nil
+}
+
+// Status return state of all embedded migrations
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	res, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrations status: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"medods/internal/client/external"
+	"medods/internal/repository"
+	"medods/internal/repository/attempt"
+	"medods/internal/repository/audit"
+	"medods/internal/repository/blacklist"
+	"medods/internal/repository/outbox"
+	"medods/internal/repository/signingkey"
+	"medods/internal/repository/subscription"
+	token2 "medods/internal/repository/token"
+	user2 "medods/internal/repository/user"
+	audit2 "medods/internal/service/audit"
+	"medods/internal/service/key"
+	"medods/internal/service/revocation"
+	"medods/internal/service/token"
+	"medods/internal/service/user"
+	"medods/internal/service/webhook"
+)
+
+// implementations of dependencies of services, services themselves don't import them
+var (
+	_ user.UserRepository            = (*user2.UserRepository)(nil)
+	_ user.UserRepository            = (*repository.MemoryRepository[user2.User, user2.FieldName])(nil)
+	_ user.AuditLogger               = (*audit2.AuditLogger)(nil)
+	_ token.TokenRepository          = (*token2.TokenRepository)(nil)
+	_ token.TokenRepository          = (*token2.MemoryRepository)(nil)
+	_ token.BlacklistRepository      = (*blacklist.BlacklistRepository)(nil)
+	_ token.BlacklistRepository      = (*repository.MemoryRepository[blacklist.Blacklist, blacklist.FieldName])(nil)
+	_ token.RevocationCache          = (*revocation.Cache)(nil)
+	_ token.Outbox                   = (*webhook.WebhookService)(nil)
+	_ token.AuditLogger              = (*audit2.AuditLogger)(nil)
+	_ key.SigningKeyRepository       = (*signingkey.SigningKeyRepository)(nil)
+	_ key.SigningKeyRepository       = (*signingkey.MemoryRepository)(nil)
+	_ revocation.BlacklistRepository = (*blacklist.BlacklistRepository)(nil)
+	_ revocation.Source              = (*blacklist.Listener)(nil)
+	_ webhook.OutboxRepository       = (*outbox.OutboxRepository)(nil)
+	_ webhook.OutboxRepository       = (*outbox.MemoryRepository)(nil)
+	_ webhook.SubscriptionRepository = (*subscription.SubscriptionRepository)(nil)
+	_ webhook.SubscriptionRepository = (*repository.MemoryRepository[subscription.Subscription, subscription.FieldName])(nil)
+	_ webhook.AttemptRepository      = (*attempt.AttemptRepository)(nil)
+	_ webhook.AttemptRepository      = (*repository.MemoryRepository[attempt.Attempt, attempt.FieldName])(nil)
+	_ webhook.Sender                 = (*external.ExternalServiceClient)(nil)
+	_ audit2.AuditRepository         = (*audit.AuditRepository)(nil)
+	_ audit2.AuditRepository         = (*repository.MemoryRepository[audit.Event, audit.FieldName])(nil)
+)
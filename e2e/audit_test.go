@@ -0,0 +1,154 @@
+package e2e
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"medods/internal/domain/audit"
+	audit2 "medods/internal/service/audit"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAuditLog(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+
+	pair := s.authorize(t, guid)
+	if status, _ := s.refresh(t, pair, firstIP, ""); status != http.StatusOK {
+		t.Fatalf("refresh: status %d", status)
+	}
+	time.Sleep(reuseGracePeriod)
+	if status, _ := s.refresh(t, pair, firstIP, ""); status != http.StatusBadRequest {
+		t.Fatalf("replayed refresh: status %d", status)
+	}
+	pair = s.authorize(t, guid)
+	if status, _ := s.refresh(t, pair, firstIP, "another-agent/2.0"); status != http.StatusBadRequest {
+		t.Fatalf("refresh with another user agent: status %d", status)
+	}
+	pair = s.authorize(t, guid)
+	if status := s.logout(t, pair.AccessToken); status != http.StatusOK {
+		t.Fatalf("logout: status %d", status)
+	}
+	//events of another user aren't returned
+	s.authorize(t, uuid.NewString())
+
+	query := "?user_guid=" + guid
+	exported := s.exportAudit(t, query)
+	want := []struct{ typ, outcome string }{
+		{audit.EventAuthorize, audit.OutcomeSuccess},
+		{audit.EventRefresh, audit.OutcomeSuccess},
+		{audit.EventSessionRevoked, audit.OutcomeSuccess},
+		{audit.EventTokenReuse, audit.OutcomeFailure},
+		{audit.EventAuthorize, audit.OutcomeSuccess},
+		{audit.EventUserAgentMismatch, audit.OutcomeFailure},
+		{audit.EventAuthorize, audit.OutcomeSuccess},
+		{audit.EventLogout, audit.OutcomeSuccess},
+	}
+	if len(exported) != len(want) {
+		t.Fatalf("got %d exported events, want %d: %+v", len(exported), len(want), exported)
+	}
+	for i, v := range exported {
+		if v.Type != want[i].typ || v.Outcome != want[i].outcome {
+			t.Errorf("event %d is %s/%s, want %s/%s", i, v.Type, v.Outcome, want[i].typ, want[i].outcome)
+		}
+		if v.UserGuid != guid || v.SessionID == "" || v.IP != firstIP || v.UserAgent == "" {
+			t.Errorf("event %d has incomplete metadata: %+v", i, v)
+		}
+	}
+	if exported[2].Reason != "reuse_detected" || exported[5].UserAgent != "another-agent/2.0" {
+		t.Errorf("unexpected security events: %+v, %+v", exported[2], exported[5])
+	}
+
+	//query returns the same events, the newest first
+	listed := s.queryAudit(t, query)
+	slices.Reverse(listed)
+	if !slices.EqualFunc(listed, exported, func(a, b audit.Event) bool { return a.ID == b.ID }) {
+		t.Fatalf("listed events %+v differ from exported %+v", listed, exported)
+	}
+	if got := s.queryAudit(t, query+"&type=logout&limit=10"); len(got) != 1 || got[0].Type != audit.EventLogout {
+		t.Fatalf("unexpected logout events: %+v", got)
+	}
+	if got := s.queryAudit(t, query+"&limit=2&offset=1"); len(got) != 2 || got[0].ID != exported[6].ID {
+		t.Fatalf("unexpected page: %+v", got)
+	}
+
+	//time range
+	future := url.QueryEscape(time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	past := url.QueryEscape(time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))
+	if got := s.queryAudit(t, query+"&from="+future); len(got) != 0 {
+		t.Fatalf("got %d events from future", len(got))
+	}
+	if got := s.exportAudit(t, query+"&from="+past+"&to="+future); len(got) != len(want) {
+		t.Fatalf("got %d events in range, want %d", len(got), len(want))
+	}
+
+	if status, _ := s.do(t, request{method: http.MethodGet, path: "/audit/events"}); status != http.StatusUnauthorized {
+		t.Fatalf("query without credentials: status %d, want %d", status, http.StatusUnauthorized)
+	}
+	//resource servers can't read history of all users
+	for _, path := range []string{"/audit/events", "/audit/events/export"} {
+		if status, _ := s.do(t, request{method: http.MethodGet, path: path, headers: map[string]string{"Authorization": clientAuth}}); status != http.StatusUnauthorized {
+			t.Fatalf("%s with client credentials: status %d, want %d", path, status, http.StatusUnauthorized)
+		}
+	}
+}
+
+func (s *server) queryAudit(t *testing.T, query string) []audit.Event {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodGet, path: "/audit/events" + query, headers: map[string]string{"Authorization": adminAuth}})
+	var events []audit.Event
+	if status != http.StatusOK || json.Unmarshal(body, &events) != nil {
+		t.Fatalf("query audit: status %d, body %s", status, body)
+	}
+	return events
+}
+
+// exportAudit return events exported as JSON Lines
+func (s *server) exportAudit(t *testing.T, query string) []audit.Event {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodGet, path: "/audit/events/export" + query, headers: map[string]string{"Authorization": adminAuth}})
+	if status != http.StatusOK {
+		t.Fatalf("export audit: status %d, body %s", status, body)
+	}
+	events := make([]audit.Event, 0)
+	sc := bufio.NewScanner(bytes.NewReader(body))
+	for sc.Scan() {
+		var e audit.Event
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			t.Fatalf("line %q isn't json: %s", sc.Text(), err)
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestAuditFailureDoesNotAbortTransaction(t *testing.T) {
+	if os.Getenv("E2E_STORAGE") != "postgres" {
+		t.Skip("transactions are rolled back only by postgres storage")
+	}
+	ctx := context.Background()
+	st := newStorage(t)
+	al := audit2.NewAuditLogger(st.AuditRepo)
+	guid := uuid.NewString()
+
+	err := st.Uow.Do(ctx, func(ctx context.Context) error {
+		//postgres rejects NUL in text, so insert of the first event fails
+		al.Log(ctx, audit.Event{Type: audit.EventLogout, Outcome: audit.OutcomeSuccess, UserGuid: guid, UserAgent: "broken\x00agent"})
+		al.Log(ctx, audit.Event{Type: audit.EventLogout, Outcome: audit.OutcomeSuccess, UserGuid: guid, UserAgent: userAgent})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("transaction is aborted by failed audit event: %s", err)
+	}
+	events, err := al.Events(ctx, audit.Filter{UserGuid: guid})
+	if err != nil || len(events) != 1 || events[0].UserAgent != userAgent {
+		t.Fatalf("unexpected audit events: %+v, error %v", events, err)
+	}
+}
@@ -0,0 +1,205 @@
+package e2e
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuthorizeAndRefresh(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+
+	pair := s.authorize(t, guid)
+	if pair.AccessToken == "" || pair.RefreshToken == "" {
+		t.Fatalf("empty tokens: %+v", pair)
+	}
+	if status, got := s.userGuid(t, pair.AccessToken); status != http.StatusOK || got != guid {
+		t.Fatalf("user guid: status %d, guid %q, want %q", status, got, guid)
+	}
+
+	status, refreshed := s.refresh(t, pair, firstIP, "")
+	if status != http.StatusOK {
+		t.Fatalf("refresh: status %d", status)
+	}
+	if refreshed.RefreshToken == pair.RefreshToken {
+		t.Fatal("refresh token isn't rotated")
+	}
+	if refreshed.ExpiresIn <= 0 || refreshed.RefreshExpiresIn <= 0 {
+		t.Fatalf("expiration isn't set: %+v", refreshed)
+	}
+	if status, got := s.userGuid(t, refreshed.AccessToken); status != http.StatusOK || got != guid {
+		t.Fatalf("user guid by refreshed token: status %d, guid %q", status, got)
+	}
+
+	//refreshed pair can be refreshed again
+	if status, _ := s.refresh(t, refreshed, firstIP, ""); status != http.StatusOK {
+		t.Fatalf("second refresh: status %d", status)
+	}
+}
+
+func TestReplayedRefreshToken(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	pair := s.authorize(t, guid)
+
+	status, refreshed := s.refresh(t, pair, firstIP, "")
+	if status != http.StatusOK {
+		t.Fatalf("refresh: status %d", status)
+	}
+
+	time.Sleep(reuseGracePeriod)
+	if status, _ := s.refresh(t, pair, firstIP, ""); status != http.StatusBadRequest {
+		t.Fatalf("replayed refresh: status %d, want %d", status, http.StatusBadRequest)
+	}
+	//reuse revokes the whole family
+	if status, _ := s.refresh(t, refreshed, firstIP, ""); status != http.StatusBadRequest {
+		t.Fatalf("refresh of revoked family: status %d, want %d", status, http.StatusBadRequest)
+	}
+	if status, _ := s.userGuid(t, refreshed.AccessToken); status != http.StatusUnauthorized {
+		t.Fatalf("access token of revoked family: status %d, want %d", status, http.StatusUnauthorized)
+	}
+
+	events := s.webhooks.waitEvents(t, "token.reuse_detected", 1)
+	if len(events) != 1 {
+		t.Fatalf("got %d reuse webhooks, want 1", len(events))
+	}
+	if events[0].Data["user_guid"] != guid {
+		t.Fatalf("unexpected webhook: %+v", events[0])
+	}
+}
+
+func TestUserAgentMismatch(t *testing.T) {
+	s := newServer(t)
+	pair := s.authorize(t, uuid.NewString())
+
+	if status, _ := s.refresh(t, pair, firstIP, "another-agent/2.0"); status != http.StatusBadRequest {
+		t.Fatalf("refresh with another user agent: status %d, want %d", status, http.StatusBadRequest)
+	}
+	if status, _ := s.userGuid(t, pair.AccessToken); status != http.StatusUnauthorized {
+		t.Fatalf("access token after mismatch: status %d, want %d", status, http.StatusUnauthorized)
+	}
+}
+
+func TestIPChangeWebhook(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	pair := s.authorize(t, guid)
+
+	status, refreshed := s.refresh(t, pair, secondIP, "")
+	if status != http.StatusOK {
+		t.Fatalf("refresh from another ip: status %d", status)
+	}
+	if refreshed.AccessToken == "" {
+		t.Fatal("empty access token")
+	}
+
+	events := s.webhooks.waitEvents(t, "ip.changed", 1)
+	if len(events) != 1 {
+		t.Fatalf("got %d ip webhooks, want 1", len(events))
+	}
+	if events[0].Data["user_guid"] != guid {
+		t.Fatalf("unexpected webhook: %+v", events[0])
+	}
+}
+
+func TestLogoutBlacklistsToken(t *testing.T) {
+	s := newServer(t)
+	pair := s.authorize(t, uuid.NewString())
+
+	if status := s.logout(t, pair.AccessToken); status != http.StatusOK {
+		t.Fatalf("logout: status %d", status)
+	}
+	if status, _ := s.userGuid(t, pair.AccessToken); status != http.StatusUnauthorized {
+		t.Fatalf("blacklisted access token: status %d, want %d", status, http.StatusUnauthorized)
+	}
+	if status, _ := s.refresh(t, pair, firstIP, ""); status != http.StatusBadRequest {
+		t.Fatalf("refresh after logout: status %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestMalformedTokens(t *testing.T) {
+	s := newServer(t)
+	pair := s.authorize(t, uuid.NewString())
+
+	for _, v := range []string{"", "garbage", "a.b.c", pair.AccessToken + "x"} {
+		if status, _ := s.userGuid(t, v); status != http.StatusUnauthorized {
+			t.Errorf("access token %q: status %d, want %d", v, status, http.StatusUnauthorized)
+		}
+	}
+
+	for _, v := range []string{"garbage", "a.b", pair.RefreshToken + "x", pair.AccessToken} {
+		malformed := tokensPair{AccessToken: pair.AccessToken, RefreshToken: v}
+		if status, _ := s.refresh(t, malformed, firstIP, ""); status != http.StatusBadRequest {
+			t.Errorf("refresh token %q: status %d, want %d", v, status, http.StatusBadRequest)
+		}
+	}
+
+	//original pair is still valid
+	if status, _ := s.refresh(t, pair, firstIP, ""); status != http.StatusOK {
+		t.Fatalf("refresh of valid pair: status %d", status)
+	}
+}
+
+func TestConcurrentRefresh(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	pair := s.authorize(t, guid)
+
+	const n = 8
+	statuses := make([]int, n)
+	bodies := make([][]byte, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			status, body, err := s.send(request{
+				method: http.MethodPost,
+				path:   "/refresh",
+				body:   map[string]string{"access_token": pair.AccessToken, "refresh_token": pair.RefreshToken},
+			})
+			if err != nil {
+				t.Error(err)
+			}
+			statuses[i], bodies[i] = status, body
+		}(i)
+	}
+	wg.Wait()
+
+	//only one refresh wins, losers get conflict and don't revoke session of winner
+	var winner tokensPair
+	won := 0
+	for i, status := range statuses {
+		switch status {
+		case http.StatusOK:
+			won++
+			if err := json.Unmarshal(bodies[i], &winner); err != nil {
+				t.Fatal(err)
+			}
+		case http.StatusConflict:
+		default:
+			t.Errorf("concurrent refresh: status %d, body %s", status, bodies[i])
+		}
+	}
+	if won != 1 {
+		t.Fatalf("%d concurrent refreshes won, want 1", won)
+	}
+
+	//late loser within grace period gets conflict too
+	if status, _ := s.refresh(t, pair, firstIP, ""); status != http.StatusConflict {
+		t.Fatalf("late concurrent refresh: status %d, want %d", status, http.StatusConflict)
+	}
+	if status, got := s.userGuid(t, winner.AccessToken); status != http.StatusOK || got != guid {
+		t.Fatalf("access token of winner: status %d, guid %q", status, got)
+	}
+	if status, _ := s.refresh(t, winner, firstIP, ""); status != http.StatusOK {
+		t.Fatalf("refresh by winner: status %d", status)
+	}
+	if events := s.webhooks.events("token.reuse_detected"); len(events) != 0 {
+		t.Fatalf("concurrent refresh is detected as reuse: %+v", events)
+	}
+}
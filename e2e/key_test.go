@@ -0,0 +1,175 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"github.com/gbrlsnchs/jwt/v3"
+	"github.com/google/uuid"
+	"medods/internal/app"
+	"medods/internal/domain/key"
+	"medods/internal/domain/token"
+	key2 "medods/internal/service/key"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newKeyRing(st *app.Storage, conf key2.KeyRingConfig) *key2.KeyRing {
+	conf.ReloadInterval = time.Minute
+	conf.GracePeriod = time.Hour
+	return key2.NewKeyRing(&conf, st.SigningKeyRepo, st.Uow)
+}
+
+func TestKeyRingConfiguredActiveKid(t *testing.T) {
+	ctx := context.Background()
+	st := newStorage(t)
+	//key in database is newer than static one, but configured kid is still used for signing
+	if err := newKeyRing(st, key2.KeyRingConfig{Algorithm: "HS512"}).Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	kr := newKeyRing(st, key2.KeyRingConfig{Algorithm: "HS512", Secrets: "static-1:secret-1,static-2:secret-2", ActiveKid: "static-2"})
+	if err := kr.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if kid, _, err := kr.Signer(); err != nil || kid != "static-2" {
+		t.Fatalf("signer %q, error %v", kid, err)
+	}
+	if _, err := kr.Rotate(ctx); !errors.Is(err, key2.ErrStaticKey) {
+		t.Fatalf("rotation of configured key: %v", err)
+	}
+	if err := kr.Retire(ctx, "static-1"); !errors.Is(err, key2.ErrStaticKey) {
+		t.Fatalf("retirement of static key: %v", err)
+	}
+
+	unknown := newKeyRing(st, key2.KeyRingConfig{Algorithm: "HS512", Secrets: "static-1:secret-1", ActiveKid: "missing"})
+	if err := unknown.Init(ctx); err == nil {
+		t.Fatal("ring with unknown active kid is initialized")
+	}
+}
+
+func TestKeyRingRotate(t *testing.T) {
+	ctx := context.Background()
+	st := newStorage(t)
+	kr := newKeyRing(st, key2.KeyRingConfig{Algorithm: "HS512"})
+	if err := kr.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	oldKid, _, err := kr.Signer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := kr.Rotate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid, _, err := kr.Signer(); err != nil || kid != created.ID {
+		t.Fatalf("signer %q after rotation to %q, error %v", kid, created.ID, err)
+	}
+	if _, err = kr.Verifier(oldKid); err != nil {
+		t.Fatalf("rotated key isn't valid for verification: %s", err)
+	}
+	if err = kr.Retire(ctx, oldKid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kr.Verifier(oldKid); err == nil {
+		t.Fatal("retired key is valid for verification")
+	}
+}
+
+func TestKeyRingConcurrentInit(t *testing.T) {
+	if os.Getenv("E2E_STORAGE") != "postgres" {
+		t.Skip("replicas share keys only by postgres storage")
+	}
+	ctx := context.Background()
+	st := newStorage(t)
+
+	//replicas started with new algorithm rotate the key at the same time
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- newKeyRing(st, key2.KeyRingConfig{Algorithm: "ES256"}).Init(ctx)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stored, err := st.SigningKeyRepo.GetAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var active int
+	for _, v := range stored {
+		if key.Status(v.Status) == key.StatusActive {
+			active++
+		}
+	}
+	if active != 1 {
+		t.Fatalf("%d active keys after concurrent init", active)
+	}
+}
+
+func TestLegacyTokenWithoutKid(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+
+	//access token issued before key ring: fixed jti, no kid and session
+	legacy := func(secret string, opts ...jwt.SignOption) string {
+		now := time.Now()
+		pl := token.AccessTokenPayload{
+			Payload: jwt.Payload{
+				Subject:        guid,
+				ExpirationTime: jwt.NumericDate(now.Add(time.Hour)),
+				IssuedAt:       jwt.NumericDate(now),
+				JWTID:          "JWTID",
+			},
+			Key: uuid.NewString(),
+		}
+		signed, err := jwt.Sign(pl, jwt.NewHS512([]byte(secret)), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(signed)
+	}
+
+	if status, got := s.userGuid(t, legacy(legacySecret)); status != http.StatusOK || got != guid {
+		t.Fatalf("legacy token: status %d, guid %q", status, got)
+	}
+	if status, _ := s.userGuid(t, legacy("other-secret")); status != http.StatusUnauthorized {
+		t.Fatalf("token without kid signed by other secret: status %d", status)
+	}
+	//legacy key is used only for tokens without kid
+	if status, _ := s.userGuid(t, legacy(legacySecret, jwt.KeyID("legacy"))); status != http.StatusUnauthorized {
+		t.Fatalf("token with kid signed by legacy secret: status %d", status)
+	}
+}
+
+func TestLegacyKeyValidity(t *testing.T) {
+	ctx := context.Background()
+	st := newStorage(t)
+	if err := newKeyRing(st, key2.KeyRingConfig{Algorithm: "HS512", LegacySecret: legacySecret}).Init(ctx); err == nil {
+		t.Fatal("legacy key without end of validity is loaded")
+	}
+
+	kr := newKeyRing(st, key2.KeyRingConfig{Algorithm: "HS512", LegacySecret: legacySecret, LegacyNotAfter: time.Now().Add(-time.Minute).Format(time.RFC3339)})
+	if err := kr.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	signed, err := jwt.Sign(jwt.Payload{Subject: uuid.NewString()}, jwt.NewHS512([]byte(legacySecret)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pl jwt.Payload
+	if _, err = jwt.Verify(signed, kr.Resolver(), &pl); err == nil {
+		t.Fatal("token without kid is valid after end of legacy key")
+	}
+}
@@ -0,0 +1,211 @@
+package e2e
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+type introspection struct {
+	Active    bool     `json:"active"`
+	Sub       string   `json:"sub"`
+	ClientID  string   `json:"client_id"`
+	Sid       string   `json:"sid"`
+	Jti       string   `json:"jti"`
+	Aud       []string `json:"aud"`
+	Exp       int64    `json:"exp"`
+	TokenType string   `json:"token_type"`
+	IP        string   `json:"ip"`
+	UserAgent string   `json:"user_agent"`
+}
+
+func TestIntrospection(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	pair := s.authorize(t, guid)
+
+	//hint only defines order of lookup, token of other type is found too
+	for _, hint := range []string{"", "access_token", "refresh_token"} {
+		access := s.introspect(t, pair.AccessToken, hint)
+		if !access.Active || access.Sub != guid || access.Sid == "" || access.Jti == "" || access.TokenType != "Bearer" || len(access.Aud) == 0 {
+			t.Errorf("access token with hint %q: %+v", hint, access)
+		}
+		refresh := s.introspect(t, pair.RefreshToken, hint)
+		if !refresh.Active || refresh.Sub != guid || refresh.Sid != access.Sid || refresh.TokenType != "" || refresh.Exp == 0 {
+			t.Errorf("refresh token with hint %q: %+v", hint, refresh)
+		}
+		if refresh.IP != firstIP || refresh.UserAgent != userAgent {
+			t.Errorf("refresh token with hint %q has no session metadata: %+v", hint, refresh)
+		}
+	}
+	for _, v := range []string{"garbage", "a.b", pair.RefreshToken + "x"} {
+		if res := s.introspect(t, v, ""); res.Active {
+			t.Errorf("token %q is active: %+v", v, res)
+		}
+	}
+
+	//rotated refresh token isn't active anymore
+	status, refreshed := s.refresh(t, pair, firstIP, "")
+	if status != http.StatusOK {
+		t.Fatalf("refresh: status %d", status)
+	}
+	if res := s.introspect(t, pair.RefreshToken, "refresh_token"); res.Active {
+		t.Errorf("rotated refresh token is active: %+v", res)
+	}
+
+	if status := s.logout(t, refreshed.AccessToken); status != http.StatusOK {
+		t.Fatalf("logout: status %d", status)
+	}
+	for _, v := range []string{refreshed.AccessToken, refreshed.RefreshToken} {
+		if res := s.introspect(t, v, ""); res.Active {
+			t.Errorf("token of closed session is active: %+v", res)
+		}
+	}
+
+	form := url.Values{"token": {pair.AccessToken}}
+	if status, _ := s.do(t, request{method: http.MethodPost, path: "/introspect", form: form}); status != http.StatusUnauthorized {
+		t.Fatalf("introspect without credentials: status %d, want %d", status, http.StatusUnauthorized)
+	}
+	if status, _ := s.do(t, request{method: http.MethodPost, path: "/introspect", form: form, headers: map[string]string{"Authorization": adminAuth}}); status != http.StatusUnauthorized {
+		t.Fatalf("introspect with admin credentials: status %d, want %d", status, http.StatusUnauthorized)
+	}
+}
+
+func (s *server) introspect(t *testing.T, token string, hint string) introspection {
+	t.Helper()
+	form := url.Values{"token": {token}}
+	if hint != "" {
+		form.Set("token_type_hint", hint)
+	}
+	status, body := s.do(t, request{method: http.MethodPost, path: "/introspect", form: form, headers: map[string]string{"Authorization": clientAuth}})
+	var res introspection
+	if status != http.StatusOK || json.Unmarshal(body, &res) != nil {
+		t.Fatalf("introspect: status %d, body %s", status, body)
+	}
+	return res
+}
+
+func TestRevocation(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	pair := s.authorizeClient(t, guid, clientAuth)
+	if res := s.introspect(t, pair.RefreshToken, "refresh_token"); res.ClientID != "e2e" {
+		t.Fatalf("refresh token isn't bound to client: %+v", res)
+	}
+
+	//token of one client can't be revoked by another one
+	if status, _ := s.revoke(t, otherClientAuth, pair.RefreshToken, ""); status != http.StatusBadRequest {
+		t.Fatalf("revoke by other client: status %d, want %d", status, http.StatusBadRequest)
+	}
+	if res := s.introspect(t, pair.RefreshToken, ""); !res.Active {
+		t.Fatal("refresh token is revoked by other client")
+	}
+
+	//revocation of refresh token closes session with its access tokens, hint doesn't matter
+	if status, body := s.revoke(t, clientAuth, pair.RefreshToken, "access_token"); status != http.StatusOK {
+		t.Fatalf("revoke refresh token: status %d, body %s", status, body)
+	}
+	for _, v := range []string{pair.AccessToken, pair.RefreshToken} {
+		if res := s.introspect(t, v, ""); res.Active {
+			t.Errorf("token of revoked session is active: %+v", res)
+		}
+	}
+	if status, _ := s.userGuid(t, pair.AccessToken); status != http.StatusUnauthorized {
+		t.Fatalf("access token of revoked session: status %d, want %d", status, http.StatusUnauthorized)
+	}
+	if status, _ := s.refresh(t, pair, firstIP, ""); status != http.StatusBadRequest {
+		t.Fatalf("refresh of revoked session: status %d, want %d", status, http.StatusBadRequest)
+	}
+	events := s.webhooks.waitEvents(t, "session.revoked", 1)
+	if len(events) != 1 || events[0].Data["user_guid"] != guid || events[0].Data["reason"] != "revoked" {
+		t.Fatalf("unexpected revocation webhooks: %+v", events)
+	}
+
+	//revocation of access token blocks only it, revoked and unknown tokens are ignored
+	pair = s.authorizeClient(t, guid, clientAuth)
+	for i := 0; i < 2; i++ {
+		if status, body := s.revoke(t, clientAuth, pair.AccessToken, ""); status != http.StatusOK {
+			t.Fatalf("revoke access token: status %d, body %s", status, body)
+		}
+	}
+	if res := s.introspect(t, pair.AccessToken, ""); res.Active {
+		t.Fatalf("revoked access token is active: %+v", res)
+	}
+	if res := s.introspect(t, pair.RefreshToken, ""); !res.Active {
+		t.Fatal("refresh token is revoked with access token")
+	}
+	if status, _ := s.revoke(t, clientAuth, "unknown", ""); status != http.StatusOK {
+		t.Fatalf("revoke unknown token: status %d, want %d", status, http.StatusOK)
+	}
+
+	//tokens issued without client aren't revoked by clients
+	pair = s.authorize(t, guid)
+	for _, v := range []string{pair.AccessToken, pair.RefreshToken} {
+		if status, _ := s.revoke(t, clientAuth, v, ""); status != http.StatusBadRequest {
+			t.Errorf("revoke token without client: status %d, want %d", status, http.StatusBadRequest)
+		}
+	}
+	if status, _ := s.do(t, request{method: http.MethodPost, path: "/revoke", form: url.Values{"token": {pair.AccessToken}}}); status != http.StatusUnauthorized {
+		t.Fatalf("revoke without credentials: status %d, want %d", status, http.StatusUnauthorized)
+	}
+}
+
+// authorizeClient authorize user on behalf of client authenticated by auth
+func (s *server) authorizeClient(t *testing.T, guid string, auth string) tokensPair {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodPost, path: "/authorize?guid=" + guid, headers: map[string]string{"Authorization": auth}})
+	var pair tokensPair
+	if status != http.StatusOK || json.Unmarshal(body, &pair) != nil {
+		t.Fatalf("authorize: status %d, body %s", status, body)
+	}
+	return pair
+}
+
+func (s *server) revoke(t *testing.T, auth string, token string, hint string) (int, []byte) {
+	t.Helper()
+	form := url.Values{"token": {token}}
+	if hint != "" {
+		form.Set("token_type_hint", hint)
+	}
+	return s.do(t, request{method: http.MethodPost, path: "/revoke", form: form, headers: map[string]string{"Authorization": auth}})
+}
+
+func TestAuthorizeClient(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+
+	//client_id must be of authenticated client, otherwise anyone could bind tokens to any client
+	for _, v := range []struct {
+		name  string
+		query string
+		auth  string
+	}{
+		{name: "client_id without credentials", query: "&client_id=e2e"},
+		{name: "client_id of another client", query: "&client_id=other", auth: clientAuth},
+		{name: "unknown client", query: "&client_id=e2e", auth: "Basic ZTJlOndyb25n"},
+		{name: "administrator", auth: adminAuth},
+	} {
+		headers := map[string]string{}
+		if v.auth != "" {
+			headers["Authorization"] = v.auth
+		}
+		status, _ := s.do(t, request{method: http.MethodPost, path: "/authorize?guid=" + guid + v.query, headers: headers})
+		if status != http.StatusUnauthorized {
+			t.Errorf("%s: status %d, want %d", v.name, status, http.StatusUnauthorized)
+		}
+	}
+
+	status, body := s.do(t, request{method: http.MethodPost, path: "/authorize?client_id=e2e&guid=" + guid, headers: map[string]string{"Authorization": clientAuth}})
+	var pair tokensPair
+	if status != http.StatusOK || json.Unmarshal(body, &pair) != nil {
+		t.Fatalf("authorize with client_id of authenticated client: status %d, body %s", status, body)
+	}
+	if res := s.introspect(t, pair.RefreshToken, ""); res.ClientID != "e2e" {
+		t.Fatalf("refresh token isn't bound to client: %+v", res)
+	}
+	if res := s.introspect(t, s.authorize(t, guid).RefreshToken, ""); res.ClientID != "" {
+		t.Fatalf("token of anonymous request is bound to client: %+v", res)
+	}
+}
@@ -0,0 +1,134 @@
+package e2e
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/gbrlsnchs/jwt/v3"
+	"github.com/google/uuid"
+	"math/big"
+	"medods/internal/domain/key"
+	"medods/internal/domain/token"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type discovery struct {
+	Issuer                           string   `json:"issuer"`
+	JwksUri                          string   `json:"jwks_uri"`
+	IdTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	ResponseTypesSupported           []string `json:"response_types_supported"`
+	GrantTypesSupported              []string `json:"grant_types_supported"`
+}
+
+func TestOIDCWithAsymmetricKey(t *testing.T) {
+	s := newServerWithAlgorithm(t, "RS256")
+	guid := uuid.NewString()
+
+	d := s.discovery(t)
+	if len(d.IdTokenSigningAlgValuesSupported) != 1 || d.IdTokenSigningAlgValuesSupported[0] != "RS256" {
+		t.Fatalf("unexpected id token algorithms %v", d.IdTokenSigningAlgValuesSupported)
+	}
+	//only implemented flows are advertised, custom authorize and refresh endpoints aren't standard ones
+	if d.ResponseTypesSupported == nil || len(d.ResponseTypesSupported) != 0 || d.GrantTypesSupported == nil || len(d.GrantTypesSupported) != 0 {
+		t.Fatalf("unimplemented flows are advertised: response types %v, grant types %v", d.ResponseTypesSupported, d.GrantTypesSupported)
+	}
+	jwks := s.jwks(t)
+
+	status, body := s.do(t, request{method: http.MethodPost, path: "/authorize?scope=openid&nonce=n-0S6&client_id=e2e&guid=" + guid, headers: map[string]string{"Authorization": clientAuth}})
+	if status != http.StatusOK {
+		t.Fatalf("authorize: status %d, body %s", status, body)
+	}
+	var res struct {
+		IdToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil || res.IdToken == "" {
+		t.Fatalf("id token isn't returned: %s", body)
+	}
+
+	//relying party verifies id token by published key
+	var hd jwt.Header
+	header, _, _ := strings.Cut(res.IdToken, ".")
+	raw, err := base64.RawURLEncoding.DecodeString(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(raw, &hd); err != nil {
+		t.Fatal(err)
+	}
+	var pub *rsa.PublicKey
+	for _, k := range jwks {
+		if k.Kid == hd.KeyID && k.Kty == "RSA" {
+			pub = rsaPublicKey(t, k)
+		}
+	}
+	if pub == nil {
+		t.Fatalf("key %s isn't published in jwks %+v", hd.KeyID, jwks)
+	}
+	var pl token.IDTokenPayload
+	if _, err = jwt.Verify([]byte(res.IdToken), jwt.NewRS256(jwt.RSAPublicKey(pub)), &pl); err != nil {
+		t.Fatalf("verify id token: %s", err)
+	}
+	if pl.Subject != guid || pl.Nonce != "n-0S6" || len(pl.Audience) != 1 || pl.Audience[0] != "e2e" {
+		t.Fatalf("unexpected id token claims %+v", pl)
+	}
+}
+
+func TestOIDCWithSymmetricKey(t *testing.T) {
+	s := newServer(t)
+
+	//HMAC secret can't be published, so relying party can't verify id token
+	if d := s.discovery(t); len(d.IdTokenSigningAlgValuesSupported) != 0 {
+		t.Fatalf("symmetric algorithms are advertised %v", d.IdTokenSigningAlgValuesSupported)
+	}
+	if jwks := s.jwks(t); len(jwks) != 0 {
+		t.Fatalf("symmetric keys are published %+v", jwks)
+	}
+	status, body := s.do(t, request{method: http.MethodPost, path: "/authorize?scope=openid&guid=" + uuid.NewString()})
+	if status != http.StatusBadRequest {
+		t.Fatalf("authorize with openid scope: status %d, body %s", status, body)
+	}
+	s.authorize(t, uuid.NewString())
+}
+
+func (s *server) discovery(t *testing.T) discovery {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodGet, path: "/.well-known/openid-configuration"})
+	if status != http.StatusOK {
+		t.Fatalf("discovery: status %d, body %s", status, body)
+	}
+	var d discovery
+	if err := json.Unmarshal(body, &d); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func (s *server) jwks(t *testing.T) []key.JWK {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodGet, path: "/.well-known/jwks.json"})
+	if status != http.StatusOK {
+		t.Fatalf("jwks: status %d, body %s", status, body)
+	}
+	var set struct {
+		Keys []key.JWK `json:"keys"`
+	}
+	if err := json.Unmarshal(body, &set); err != nil {
+		t.Fatal(err)
+	}
+	return set.Keys
+}
+
+func rsaPublicKey(t *testing.T, k key.JWK) *rsa.PublicKey {
+	t.Helper()
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+}
@@ -0,0 +1,98 @@
+package e2e
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"medods/internal/domain/audit"
+	"net/http"
+	"testing"
+)
+
+func TestClientIPBehindTrustedProxy(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+
+	//address forged by client is left of the one appended by proxy
+	status, body := s.do(t, request{
+		method:  http.MethodPost,
+		path:    "/authorize?guid=" + guid,
+		headers: map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.7"},
+		ip:      proxyIP,
+	})
+	var pair tokensPair
+	if status != http.StatusOK || json.Unmarshal(body, &pair) != nil {
+		t.Fatalf("authorize through proxy: status %d, body %s", status, body)
+	}
+	//trusted hops are skipped, X-Real-IP is ignored when chain is present
+	pair = s.refreshVia(t, pair, proxyIP, map[string]string{
+		"X-Forwarded-For": "203.0.113.7, " + proxyIP,
+		"X-Real-IP":       "192.0.2.1",
+	})
+	//Forwarded has priority over X-Forwarded-For
+	pair = s.refreshVia(t, pair, proxyIP, map[string]string{
+		"Forwarded":       `for=192.0.2.60;proto=http, For="[2001:db8::1]:4711"`,
+		"X-Forwarded-For": "192.0.2.1",
+	})
+	//headers of untrusted peer are ignored
+	s.refreshVia(t, pair, firstIP, map[string]string{"X-Forwarded-For": "2001:db8::1"})
+
+	events := s.webhooks.waitEvents(t, "ip.changed", 2)
+	if len(events) != 2 {
+		t.Fatalf("got %d ip webhooks, want 2", len(events))
+	}
+	if events[0].Data["previous_ip"] != "203.0.113.7" || events[0].Data["ip"] != "2001:db8::1" {
+		t.Errorf("unexpected first ip webhook: %+v", events[0].Data)
+	}
+	if events[1].Data["previous_ip"] != "2001:db8::1" || events[1].Data["ip"] != firstIP {
+		t.Errorf("unexpected second ip webhook: %+v", events[1].Data)
+	}
+
+	wantIPs := []string{"203.0.113.7", "203.0.113.7", "2001:db8::1", firstIP}
+	exported := s.exportAudit(t, "?user_guid="+guid)
+	if len(exported) != len(wantIPs) {
+		t.Fatalf("got %d audit events, want %d: %+v", len(exported), len(wantIPs), exported)
+	}
+	for i, v := range exported {
+		if v.Outcome != audit.OutcomeSuccess || v.IP != wantIPs[i] {
+			t.Errorf("event %d is %s from %s, want success from %s", i, v.Type, v.IP, wantIPs[i])
+		}
+	}
+}
+
+func TestClientIPFromRealIPHeader(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+
+	for _, ip := range []string{proxyIP, secondIP} {
+		status, body := s.do(t, request{
+			method:  http.MethodPost,
+			path:    "/authorize?guid=" + guid,
+			headers: map[string]string{"X-Real-IP": "203.0.113.9"},
+			ip:      ip,
+		})
+		if status != http.StatusOK {
+			t.Fatalf("authorize from %s: status %d, body %s", ip, status, body)
+		}
+	}
+	exported := s.exportAudit(t, "?user_guid="+guid)
+	if len(exported) != 2 || exported[0].IP != "203.0.113.9" || exported[1].IP != secondIP {
+		t.Fatalf("unexpected audit events: %+v", exported)
+	}
+}
+
+// refreshVia refresh pair with request sent from ip with extra headers
+func (s *server) refreshVia(t *testing.T, pair tokensPair, ip string, headers map[string]string) tokensPair {
+	t.Helper()
+	status, body := s.do(t, request{
+		method:  http.MethodPost,
+		path:    "/refresh",
+		headers: headers,
+		body:    map[string]string{"access_token": pair.AccessToken, "refresh_token": pair.RefreshToken},
+		ip:      ip,
+	})
+	var refreshed tokensPair
+	if status != http.StatusOK || json.Unmarshal(body, &refreshed) != nil {
+		t.Fatalf("refresh from %s: status %d, body %s", ip, status, body)
+	}
+	return refreshed
+}
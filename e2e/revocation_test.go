@@ -0,0 +1,125 @@
+package e2e
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"medods/internal/repository/blacklist"
+	"medods/internal/service/revocation"
+	"testing"
+	"time"
+)
+
+// fakeSource notifications about blacklist entries sent by test instead of database
+type fakeSource struct {
+	created chan *blacklist.Blacklist
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{created: make(chan *blacklist.Blacklist)}
+}
+
+func (s *fakeSource) Listen(ctx context.Context) (<-chan *blacklist.Blacklist, error) {
+	return s.created, nil
+}
+
+// runCache start cache with fake source, it is stopped when test ends
+func runCache(t *testing.T, repo revocation.BlacklistRepository, source *fakeSource) *revocation.Cache {
+	t.Helper()
+	cache := revocation.NewCache(&revocation.Config{PruneInterval: time.Minute}, repo, source)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = cache.Run(ctx) }()
+	return cache
+}
+
+// waitSynced wait until cache is loaded and can answer
+func waitSynced(t *testing.T, cache *revocation.Cache) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := cache.Revoked(blacklist.JTIFieldName, uuid.NewString()); ok {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("cache isn't loaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitRevoked wait until cache reports jti as revoked
+func waitRevoked(cache *revocation.Cache, jti string) (revoked bool, ok bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		revoked, ok = cache.Revoked(blacklist.JTIFieldName, jti)
+		if revoked || time.Now().After(deadline) {
+			return revoked, ok
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRevocationCacheLoad(t *testing.T) {
+	st := newStorage(t)
+	stored := blacklist.Blacklist{JTI: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour)}
+	if err := st.BlacklistRepo.Create(context.Background(), &stored); err != nil {
+		t.Fatal(err)
+	}
+
+	//cache can't answer before it is loaded, database must be used
+	cache := revocation.NewCache(&revocation.Config{PruneInterval: time.Minute}, st.BlacklistRepo, newFakeSource())
+	if _, ok := cache.Revoked(blacklist.JTIFieldName, stored.JTI); ok {
+		t.Fatal("cache answers before it is loaded")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = cache.Run(ctx) }()
+	if revoked, ok := waitRevoked(cache, stored.JTI); !revoked || !ok {
+		t.Fatalf("stored entry isn't loaded: revoked %t, ok %t", revoked, ok)
+	}
+	if revoked, ok := cache.Revoked(blacklist.JTIFieldName, uuid.NewString()); revoked || !ok {
+		t.Fatalf("unknown jti: revoked %t, ok %t", revoked, ok)
+	}
+}
+
+func TestRevocationCacheAdd(t *testing.T) {
+	st := newStorage(t)
+	source := newFakeSource()
+	cache := runCache(t, st.BlacklistRepo, source)
+	waitSynced(t, cache)
+
+	//entry added by this replica is seen without notification
+	added := blacklist.Blacklist{JTI: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour)}
+	cache.Add(added)
+	if revoked, ok := cache.Revoked(blacklist.JTIFieldName, added.JTI); !revoked || !ok {
+		t.Fatalf("added entry: revoked %t, ok %t", revoked, ok)
+	}
+
+	//entry of other replica is seen after notification
+	notified := blacklist.Blacklist{JTI: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour)}
+	source.created <- &notified
+	if revoked, ok := waitRevoked(cache, notified.JTI); !revoked || !ok {
+		t.Fatalf("notified entry: revoked %t, ok %t", revoked, ok)
+	}
+}
+
+func TestRevocationCacheReloadOnLostNotifications(t *testing.T) {
+	st := newStorage(t)
+	source := newFakeSource()
+	cache := runCache(t, st.BlacklistRepo, source)
+	waitSynced(t, cache)
+
+	//notification about entry is lost, nil tells cache to load it from database
+	lost := blacklist.Blacklist{JTI: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour)}
+	if err := st.BlacklistRepo.Create(context.Background(), &lost); err != nil {
+		t.Fatal(err)
+	}
+	if revoked, _ := cache.Revoked(blacklist.JTIFieldName, lost.JTI); revoked {
+		t.Fatal("entry is seen without notification")
+	}
+	source.created <- nil
+	if revoked, ok := waitRevoked(cache, lost.JTI); !revoked || !ok {
+		t.Fatalf("entry isn't reloaded: revoked %t, ok %t", revoked, ok)
+	}
+}
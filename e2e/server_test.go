@@ -0,0 +1,400 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"medods/database"
+	"medods/internal/api"
+	"medods/internal/app"
+	"medods/internal/client/external"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/service/audit"
+	"medods/internal/service/client"
+	"medods/internal/service/key"
+	"medods/internal/service/token"
+	"medods/internal/service/user"
+	"medods/internal/service/webhook"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	userAgent = "e2e-test/1.0"
+	// clientAuth basic credentials of registered client
+	clientAuth = "Basic ZTJlOnNlY3JldA=="
+	// otherClientAuth basic credentials of another registered client
+	otherClientAuth = "Basic b3RoZXI6b3RoZXItc2VjcmV0"
+	// adminAuth basic credentials of administrator managing webhooks and reading audit log
+	adminAuth = "Basic YWRtaW46YWRtaW4tc2VjcmV0"
+	// legacySecret secret of access tokens issued before key ring
+	legacySecret = "secret_phrase"
+
+	// addresses of loopback interface used as different client ips
+	firstIP  = "127.0.0.1"
+	secondIP = "127.0.0.2"
+	// proxyIP address of trusted reverse proxy
+	proxyIP = "127.0.0.3"
+
+	// reuseGracePeriod time after rotation during which replayed refresh token is refused as concurrent one
+	reuseGracePeriod = 200 * time.Millisecond
+)
+
+// webhook event received by stub
+type webhookEvent struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Version   int                    `json:"version"`
+	CreatedAt time.Time              `json:"created_at"`
+	Data      map[string]interface{} `json:"data"`
+	// Header headers of request
+	Header http.Header `json:"-"`
+	// Body raw body of request
+	Body []byte `json:"-"`
+}
+
+// webhookStub local server which records received webhooks
+type webhookStub struct {
+	*httptest.Server
+	mu       sync.Mutex
+	received []webhookEvent
+	// attempts number of all received requests by type of event, failed ones too
+	attempts map[string]int
+	// failures number of next requests rejected with error by type of event
+	failures map[string]int
+}
+
+func newWebhookStub(t *testing.T) *webhookStub {
+	stub := &webhookStub{attempts: make(map[string]int), failures: make(map[string]int)}
+	stub.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		event := webhookEvent{Header: r.Header.Clone(), Body: body}
+		if err = json.Unmarshal(body, &event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		stub.attempts[event.Type]++
+		if stub.failures[event.Type] > 0 {
+			stub.failures[event.Type]--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		stub.received = append(stub.received, event)
+	}))
+	t.Cleanup(stub.Close)
+	return stub
+}
+
+// fail reject next n requests with event
+func (s *webhookStub) fail(event string, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[event] = n
+}
+
+func (s *webhookStub) attemptsCount(event string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.attempts[event]
+}
+
+// events return received webhooks with event
+func (s *webhookStub) events(event string) []webhookEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make([]webhookEvent, 0)
+	for _, v := range s.received {
+		if v.Type == event {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// waitEvents wait until n webhooks with event are delivered by dispatcher and return them
+func (s *webhookStub) waitEvents(t *testing.T, event string, n int) []webhookEvent {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		res := s.events(event)
+		if len(res) >= n || time.Now().After(deadline) {
+			return res
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+type server struct {
+	baseURL  string
+	webhooks *webhookStub
+	// subscription of webhook stub to all events
+	subscription webhookSubscription
+}
+
+// newStorage return in-memory storage or local postgres when E2E_STORAGE=postgres,
+// postgres is configured by PG_* variables and is migrated before tests
+func newStorage(t *testing.T) *app.Storage {
+	conf := &app.StorageConfig{Driver: app.StorageDriverMemory}
+	if os.Getenv("E2E_STORAGE") == "postgres" {
+		dbConf, err := database.NewConfig()
+		if err != nil {
+			t.Fatal(err)
+		}
+		dbConf.AutoMigrate = true
+		conf = &app.StorageConfig{Driver: app.StorageDriverPostgres, DB: dbConf}
+	}
+	st, err := app.NewStorage(context.Background(), conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = st.Close() })
+	return st
+}
+
+// newServer start app from StartHttpServer on random port of loopback interface
+func newServer(t *testing.T) *server {
+	t.Helper()
+	return newServerWithAlgorithm(t, "HS512")
+}
+
+func newTokenConfig() *token.TokenConfig {
+	return &token.TokenConfig{
+		Issuer:             "http://localhost:8080",
+		Audience:           "localhost:8080",
+		IDTokenTTL:         time.Hour,
+		AccessTokenTTL:     time.Hour,
+		RefreshIdleTimeout: 24 * time.Hour,
+		SessionMaxLifetime: 7 * 24 * time.Hour,
+		ReuseGracePeriod:   reuseGracePeriod,
+	}
+}
+
+// newServerWithAlgorithm start app which signs tokens by key of algorithm
+func newServerWithAlgorithm(t *testing.T, algorithm string) *server {
+	t.Helper()
+	ctx := context.Background()
+	stub := newWebhookStub(t)
+	st := newStorage(t)
+
+	keyRing := key.NewKeyRing(&key.KeyRingConfig{
+		Algorithm:      algorithm,
+		ReloadInterval: time.Minute,
+		GracePeriod:    time.Hour,
+		LegacySecret:   legacySecret,
+		LegacyNotAfter: time.Now().Add(time.Hour).Format(time.RFC3339),
+	}, st.SigningKeyRepo, st.Uow)
+	if err := keyRing.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	ws := webhook.NewWService(&webhook.Config{
+		PollInterval:   10 * time.Millisecond,
+		BatchSize:      10,
+		Lease:          time.Minute,
+		MaxAttempts:    3,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     100 * time.Millisecond,
+		//stub receiver listens on loopback
+		AllowInsecureTargets: true,
+	}, st.OutboxRepo, st.SubscriptionRepo, st.AttemptRepo, external.NewClient(nil))
+	dispatcherCtx, stopDispatcher := context.WithCancel(ctx)
+	go ws.Run(dispatcherCtx)
+	t.Cleanup(stopDispatcher)
+
+	al := audit.NewAuditLogger(st.AuditRepo)
+	ts := token.NewTService(newTokenConfig(), ws, st.BlacklistRepo, st.TokenRepo, keyRing, st.Uow, runCache(t, st.BlacklistRepo, newFakeSource()), al)
+	us := user.NewUService(st.UserRepo, ts, st.Uow, al)
+	cs, err := client.NewCService(&client.ClientConfig{Credentials: "e2e:secret,other:other-secret", AdminCredentials: "admin:admin-secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ipr, err := api.NewIPResolver(&api.Config{TrustedProxies: proxyIP})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ln, err := net.Listen("tcp", net.JoinHostPort(firstIP, "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fiberApp := api.NewApp(ts, us, ws, al, cs, ipr)
+	go func() { _ = fiberApp.Listener(ln) }()
+	t.Cleanup(func() { _ = fiberApp.Shutdown() })
+
+	s := &server{baseURL: "http://" + ln.Addr().String(), webhooks: stub}
+	status, sub := s.createSubscription(t, stub.URL+"/security", webhook2.EventTypes)
+	if status != http.StatusCreated {
+		t.Fatalf("create webhook subscription: status %d", status)
+	}
+	s.subscription = sub
+	return s
+}
+
+type request struct {
+	method  string
+	path    string
+	headers map[string]string
+	body    interface{}
+	// form body sent as application/x-www-form-urlencoded instead of json body
+	form url.Values
+	// ip local address of client
+	ip string
+}
+
+// do send request from ip of request and return status and body of response
+func (s *server) do(t *testing.T, r request) (int, []byte) {
+	t.Helper()
+	status, body, err := s.send(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return status, body
+}
+
+// send request from ip of request, unlike do it can be called from goroutines started by test
+func (s *server) send(r request) (int, []byte, error) {
+	if r.ip == "" {
+		r.ip = firstIP
+	}
+
+	var body io.Reader
+	contentType := ""
+	switch {
+	case r.form != nil:
+		body, contentType = strings.NewReader(r.form.Encode()), "application/x-www-form-urlencoded"
+	case r.body != nil:
+		raw, err := json.Marshal(r.body)
+		if err != nil {
+			return 0, nil, err
+		}
+		body, contentType = bytes.NewReader(raw), "application/json"
+	}
+	req, err := http.NewRequest(r.method, s.baseURL+r.path, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	for k, v := range r.headers {
+		req.Header.Set(k, v)
+	}
+
+	dialer := &net.Dialer{LocalAddr: &net.TCPAddr{IP: net.ParseIP(r.ip)}}
+	httpClient := &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: &http.Transport{DialContext: dialer.DialContext, DisableKeepAlives: true},
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+type tokensPair struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	RefreshExpiresIn int    `json:"refresh_expires_in"`
+}
+
+func (s *server) authorize(t *testing.T, guid string) tokensPair {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodPost, path: "/authorize?guid=" + guid})
+	if status != http.StatusOK {
+		t.Fatalf("authorize: status %d, body %s", status, body)
+	}
+	var pair tokensPair
+	if err := json.Unmarshal(body, &pair); err != nil {
+		t.Fatal(err)
+	}
+	return pair
+}
+
+func (s *server) refresh(t *testing.T, pair tokensPair, ip string, ua string) (int, tokensPair) {
+	t.Helper()
+	headers := map[string]string{}
+	if ua != "" {
+		headers["User-Agent"] = ua
+	}
+	status, body := s.do(t, request{
+		method:  http.MethodPost,
+		path:    "/refresh",
+		headers: headers,
+		body:    map[string]string{"access_token": pair.AccessToken, "refresh_token": pair.RefreshToken},
+		ip:      ip,
+	})
+	var refreshed tokensPair
+	if status == http.StatusOK {
+		if err := json.Unmarshal(body, &refreshed); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return status, refreshed
+}
+
+func (s *server) userGuid(t *testing.T, accessToken string) (int, string) {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodGet, path: "/user/guid", headers: map[string]string{"Authorization": accessToken}})
+	var res struct {
+		Guid string `json:"user_guid"`
+	}
+	if status == http.StatusOK {
+		if err := json.Unmarshal(body, &res); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return status, res.Guid
+}
+
+func (s *server) logout(t *testing.T, accessToken string) int {
+	t.Helper()
+	status, _ := s.do(t, request{method: http.MethodPost, path: "/user/logout", headers: map[string]string{"Authorization": accessToken}})
+	return status
+}
+
+type webhookSubscription struct {
+	ID         string   `json:"id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Active     bool     `json:"active"`
+	Secret     string   `json:"secret"`
+}
+
+func (s *server) createSubscription(t *testing.T, url string, eventTypes []string) (int, webhookSubscription) {
+	t.Helper()
+	status, body := s.do(t, request{
+		method:  http.MethodPost,
+		path:    "/webhooks/subscriptions",
+		headers: map[string]string{"Authorization": adminAuth},
+		body:    map[string]interface{}{"url": url, "event_types": eventTypes},
+	})
+	var sub webhookSubscription
+	if status == http.StatusCreated {
+		if err := json.Unmarshal(body, &sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return status, sub
+}
@@ -0,0 +1,187 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	request2 "medods/internal/domain/request"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/repository"
+	"medods/internal/service/audit"
+	key2 "medods/internal/service/key"
+	"medods/internal/service/token"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type session struct {
+	ID        string `json:"id"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+	Current   bool   `json:"current"`
+}
+
+func TestSessions(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	first := s.authorize(t, guid)
+	second := s.authorize(t, guid)
+
+	sessions := s.sessions(t, first.AccessToken)
+	if len(sessions) != 2 {
+		t.Fatalf("unexpected sessions %+v", sessions)
+	}
+	var other string
+	for _, v := range sessions {
+		if v.UserAgent != userAgent || v.IP != firstIP {
+			t.Fatalf("unexpected session %+v", v)
+		}
+		if !v.Current {
+			other = v.ID
+		}
+	}
+	if other == "" {
+		t.Fatalf("current session isn't marked %+v", sessions)
+	}
+
+	//session of another user isn't found
+	stranger := s.authorize(t, uuid.NewString())
+	if status := s.revokeSession(t, stranger.AccessToken, other); status != http.StatusNotFound {
+		t.Fatalf("revoke session of another user: status %d", status)
+	}
+	if status := s.revokeSession(t, first.AccessToken, uuid.NewString()); status != http.StatusNotFound {
+		t.Fatalf("revoke unknown session: status %d", status)
+	}
+
+	//revoked session can't be used anymore
+	if status := s.revokeSession(t, first.AccessToken, other); status != http.StatusOK {
+		t.Fatalf("revoke session: status %d", status)
+	}
+	if status, _ := s.userGuid(t, second.AccessToken); status != http.StatusUnauthorized {
+		t.Fatalf("access token of revoked session: status %d", status)
+	}
+	if status, _ := s.refresh(t, second, firstIP, ""); status != http.StatusBadRequest {
+		t.Fatalf("refresh token of revoked session: status %d", status)
+	}
+	if sessions = s.sessions(t, first.AccessToken); len(sessions) != 1 || !sessions[0].Current {
+		t.Fatalf("sessions after revocation %+v", sessions)
+	}
+
+	//logout from all sessions keeps sessions of other users
+	third := s.authorize(t, guid)
+	status, _ := s.do(t, request{method: http.MethodPost, path: "/user/logout-all", headers: map[string]string{"Authorization": third.AccessToken}})
+	if status != http.StatusOK {
+		t.Fatalf("logout from all sessions: status %d", status)
+	}
+	for _, v := range []tokensPair{first, third} {
+		if status, _ := s.userGuid(t, v.AccessToken); status != http.StatusUnauthorized {
+			t.Fatalf("access token after logout from all sessions: status %d", status)
+		}
+	}
+	if status, _ := s.userGuid(t, stranger.AccessToken); status != http.StatusOK {
+		t.Fatalf("access token of another user: status %d", status)
+	}
+}
+
+func (s *server) sessions(t *testing.T, accessToken string) []session {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodGet, path: "/user/sessions", headers: map[string]string{"Authorization": accessToken}})
+	if status != http.StatusOK {
+		t.Fatalf("sessions: status %d, body %s", status, body)
+	}
+	var res []session
+	if err := json.Unmarshal(body, &res); err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func (s *server) revokeSession(t *testing.T, accessToken string, id string) int {
+	t.Helper()
+	status, _ := s.do(t, request{method: http.MethodDelete, path: "/user/sessions/" + id, headers: map[string]string{"Authorization": accessToken}})
+	return status
+}
+
+type txIDKey struct{}
+
+// trackedUow unit of work which marks context of its outermost call with number of transaction
+type trackedUow struct {
+	repository.Transactor
+	n atomic.Int64
+}
+
+func (u *trackedUow) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txIDKey{}).(int64); ok {
+		return u.Transactor.Do(ctx, fn)
+	}
+	id := u.n.Add(1)
+	return u.Transactor.Do(ctx, func(ctx context.Context) error {
+		return fn(context.WithValue(ctx, txIDKey{}, id))
+	})
+}
+
+// failingOutbox outbox which fails on second revocation of session and records transactions of revocations
+type failingOutbox struct {
+	mu      sync.Mutex
+	fail    bool
+	revoked []int64
+}
+
+func (o *failingOutbox) Publish(ctx context.Context, eventType string, data interface{}) error {
+	if eventType != webhook2.EventSessionRevoked {
+		return nil
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	id, _ := ctx.Value(txIDKey{}).(int64)
+	o.revoked = append(o.revoked, id)
+	if o.fail && len(o.revoked) == 2 {
+		return errors.New("outbox is unavailable")
+	}
+	return nil
+}
+
+func TestRevokeAllSessionsIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	st := newStorage(t)
+	keyRing := newKeyRing(st, key2.KeyRingConfig{Algorithm: "HS512"})
+	if err := keyRing.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	outbox := &failingOutbox{fail: true}
+	uow := &trackedUow{Transactor: st.Uow}
+	ts := token.NewTService(newTokenConfig(), outbox, st.BlacklistRepo, st.TokenRepo, keyRing, uow, nil, audit.NewAuditLogger(st.AuditRepo))
+
+	guid := uuid.NewString()
+	for i := 0; i < 2; i++ {
+		if _, err := ts.Build(ctx, guid, request2.RequestData{UserAgent: userAgent, IP: firstIP}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	//failure on the second session rolls back revocation of the first one
+	if err := ts.RevokeAllSessions(ctx, guid); err == nil {
+		t.Fatal("failure of outbox isn't returned")
+	}
+	if len(outbox.revoked) != 2 || outbox.revoked[0] == 0 || outbox.revoked[0] != outbox.revoked[1] {
+		t.Fatalf("sessions are revoked in different transactions %v", outbox.revoked)
+	}
+	//in-memory storage doesn't roll back, so result of rollback is checked only by postgres
+	if os.Getenv("E2E_STORAGE") == "postgres" {
+		if sessions, err := ts.Sessions(ctx, guid); err != nil || len(sessions) != 2 {
+			t.Fatalf("sessions after failed revocation %+v, error %v", sessions, err)
+		}
+	}
+
+	outbox.fail = false
+	if err := ts.RevokeAllSessions(ctx, guid); err != nil {
+		t.Fatal(err)
+	}
+	if sessions, err := ts.Sessions(ctx, guid); err != nil || len(sessions) != 0 {
+		t.Fatalf("sessions after revocation %+v, error %v", sessions, err)
+	}
+}
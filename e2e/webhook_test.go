@@ -0,0 +1,354 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"medods/internal/client/external"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/repository/attempt"
+	"medods/internal/repository/outbox"
+	"medods/internal/repository/subscription"
+	"medods/internal/service/webhook"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWebhookRetriedUntilAccepted(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	pair := s.authorize(t, guid)
+
+	s.webhooks.fail(webhook2.EventIPChanged, 2)
+	if status, _ := s.refresh(t, pair, secondIP, ""); status != http.StatusOK {
+		t.Fatalf("refresh from another ip: status %d", status)
+	}
+
+	events := s.webhooks.waitEvents(t, "ip.changed", 1)
+	if len(events) != 1 || events[0].Data["user_guid"] != guid {
+		t.Fatalf("unexpected webhooks: %+v", events)
+	}
+	if n := s.webhooks.attemptsCount(webhook2.EventIPChanged); n != 3 {
+		t.Fatalf("got %d attempts, want 3", n)
+	}
+}
+
+func TestWebhookDeadLettered(t *testing.T) {
+	s := newServer(t)
+	pair := s.authorize(t, uuid.NewString())
+
+	s.webhooks.fail(webhook2.EventIPChanged, 100)
+	if status, _ := s.refresh(t, pair, secondIP, ""); status != http.StatusOK {
+		t.Fatalf("refresh from another ip: status %d", status)
+	}
+
+	//dispatcher gives up after max attempts
+	deadline := time.Now().Add(5 * time.Second)
+	for s.webhooks.attemptsCount(webhook2.EventIPChanged) < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(300 * time.Millisecond)
+	if n := s.webhooks.attemptsCount(webhook2.EventIPChanged); n != 3 {
+		t.Fatalf("got %d attempts, want 3", n)
+	}
+	if events := s.webhooks.events("ip.changed"); len(events) != 0 {
+		t.Fatalf("dead webhook is delivered: %+v", events)
+	}
+}
+
+func TestWebhookSignedEnvelope(t *testing.T) {
+	s := newServer(t)
+	guid := uuid.NewString()
+	s.authorize(t, guid)
+
+	events := s.webhooks.waitEvents(t, webhook2.EventSessionCreated, 1)
+	if len(events) != 1 {
+		t.Fatalf("got %d session webhooks, want 1", len(events))
+	}
+	e := events[0]
+	if e.ID == "" || e.Version != webhook2.SchemaVersion || e.CreatedAt.IsZero() {
+		t.Fatalf("unexpected envelope: %+v", e)
+	}
+	if e.Data["user_guid"] != guid || e.Data["session_id"] == "" || e.Data["user_agent"] != userAgent {
+		t.Fatalf("unexpected data: %+v", e.Data)
+	}
+	if e.Header.Get(webhook.HeaderID) != e.ID || e.Header.Get(webhook.HeaderEvent) != e.Type {
+		t.Fatalf("unexpected headers: %+v", e.Header)
+	}
+
+	timestamp, err := strconv.ParseInt(e.Header.Get(webhook.HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("timestamp header: %s", err)
+	}
+	if d := time.Since(time.Unix(timestamp, 0)); d < -time.Minute || d > time.Minute {
+		t.Fatalf("timestamp is off by %s", d)
+	}
+	if got, want := e.Header.Get(webhook.HeaderSignature), webhook.Sign(s.subscription.Secret, timestamp, e.Body); got != want {
+		t.Fatalf("signature %q, want %q", got, want)
+	}
+	if webhook.Sign("another-secret", timestamp, e.Body) == e.Header.Get(webhook.HeaderSignature) {
+		t.Fatal("signature doesn't depend on secret")
+	}
+}
+
+func TestWebhookSubscriptionEventTypes(t *testing.T) {
+	s := newServer(t)
+	revocations := newWebhookStub(t)
+	status, _ := s.createSubscription(t, revocations.URL, []string{webhook2.EventSessionRevoked})
+	if status != http.StatusCreated {
+		t.Fatalf("create subscription: status %d", status)
+	}
+
+	guid := uuid.NewString()
+	pair := s.authorize(t, guid)
+	if status := s.logout(t, pair.AccessToken); status != http.StatusOK {
+		t.Fatalf("logout: status %d", status)
+	}
+
+	events := revocations.waitEvents(t, webhook2.EventSessionRevoked, 1)
+	if len(events) != 1 || events[0].Data["user_guid"] != guid || events[0].Data["reason"] != webhook2.RevokeReasonLogout {
+		t.Fatalf("unexpected webhooks: %+v", events)
+	}
+	if n := revocations.attemptsCount(webhook2.EventSessionCreated); n != 0 {
+		t.Fatalf("got %d not subscribed webhooks", n)
+	}
+}
+
+func TestWebhookSubscriptionsManagement(t *testing.T) {
+	s := newServer(t)
+	path := "/webhooks/subscriptions/" + s.subscription.ID
+	auth := map[string]string{"Authorization": adminAuth}
+
+	if status, _ := s.do(t, request{method: http.MethodGet, path: "/webhooks/subscriptions"}); status != http.StatusUnauthorized {
+		t.Fatalf("list without credentials: status %d, want %d", status, http.StatusUnauthorized)
+	}
+	//resource servers can't subscribe to events of all users
+	clientReq := request{method: http.MethodPost, path: "/webhooks/subscriptions", headers: map[string]string{"Authorization": clientAuth},
+		body: map[string]interface{}{"url": s.webhooks.URL, "event_types": webhook2.EventTypes}}
+	if status, _ := s.do(t, clientReq); status != http.StatusUnauthorized {
+		t.Fatalf("create with client credentials: status %d, want %d", status, http.StatusUnauthorized)
+	}
+	for _, v := range [][]string{{"unknown.event"}, {}} {
+		if status, _ := s.createSubscription(t, s.webhooks.URL, v); status != http.StatusBadRequest {
+			t.Errorf("event types %v: status %d, want %d", v, status, http.StatusBadRequest)
+		}
+	}
+	if status, _ := s.createSubscription(t, "not-a-url", webhook2.EventTypes); status != http.StatusBadRequest {
+		t.Errorf("invalid url: status %d, want %d", status, http.StatusBadRequest)
+	}
+
+	status, body := s.do(t, request{method: http.MethodGet, path: "/webhooks/subscriptions", headers: auth})
+	var subs []webhookSubscription
+	if status != http.StatusOK || json.Unmarshal(body, &subs) != nil || len(subs) != 1 {
+		t.Fatalf("list: status %d, body %s", status, body)
+	}
+	if subs[0].ID != s.subscription.ID || subs[0].Secret != "" {
+		t.Fatalf("unexpected subscription: %+v", subs[0])
+	}
+
+	status, body = s.do(t, request{method: http.MethodPatch, path: path, headers: auth,
+		body: map[string]interface{}{"active": false, "event_types": []string{webhook2.EventIPChanged}}})
+	var updated webhookSubscription
+	if status != http.StatusOK || json.Unmarshal(body, &updated) != nil {
+		t.Fatalf("update: status %d, body %s", status, body)
+	}
+	if updated.Active || len(updated.EventTypes) != 1 || updated.EventTypes[0] != webhook2.EventIPChanged || updated.URL != s.subscription.URL {
+		t.Fatalf("unexpected updated subscription: %+v", updated)
+	}
+
+	if status, _ := s.do(t, request{method: http.MethodDelete, path: path, headers: auth}); status != http.StatusOK {
+		t.Fatalf("delete: status %d", status)
+	}
+	if status, _ := s.do(t, request{method: http.MethodGet, path: path, headers: auth}); status != http.StatusNotFound {
+		t.Fatalf("get deleted: status %d, want %d", status, http.StatusNotFound)
+	}
+	if status, _ := s.do(t, request{method: http.MethodDelete, path: path, headers: auth}); status != http.StatusNotFound {
+		t.Fatalf("delete deleted: status %d, want %d", status, http.StatusNotFound)
+	}
+}
+
+type webhookDelivery struct {
+	ID         int64                    `json:"id"`
+	EventType  string                   `json:"event_type"`
+	Status     string                   `json:"status"`
+	Attempts   int                      `json:"attempts"`
+	LastError  string                   `json:"last_error"`
+	Payload    map[string]interface{}   `json:"payload"`
+	AttemptLog []webhookDeliveryAttempt `json:"attempt_log"`
+}
+
+type webhookDeliveryAttempt struct {
+	URL            string            `json:"url"`
+	RequestHeaders map[string]string `json:"request_headers"`
+	ResponseStatus int               `json:"response_status"`
+	Error          string            `json:"error"`
+	Replay         bool              `json:"replay"`
+}
+
+func TestWebhookDeliveryLogAndReplay(t *testing.T) {
+	s := newServer(t)
+	auth := map[string]string{"Authorization": adminAuth}
+	guid := uuid.NewString()
+	pair := s.authorize(t, guid)
+
+	s.webhooks.fail(webhook2.EventIPChanged, 100)
+	if status, _ := s.refresh(t, pair, secondIP, ""); status != http.StatusOK {
+		t.Fatalf("refresh from another ip: status %d", status)
+	}
+
+	//wait until dispatcher gives up
+	var deliveries []webhookDelivery
+	deadline := time.Now().Add(5 * time.Second)
+	for len(deliveries) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		status, body := s.do(t, request{method: http.MethodGet, path: "/webhooks/deliveries?event_type=ip.changed&status=dead", headers: auth})
+		if status != http.StatusOK || json.Unmarshal(body, &deliveries) != nil {
+			t.Fatalf("list deliveries: status %d, body %s", status, body)
+		}
+	}
+	if len(deliveries) != 1 || deliveries[0].Attempts != 3 || deliveries[0].LastError == "" {
+		t.Fatalf("unexpected dead deliveries: %+v", deliveries)
+	}
+	if data, _ := deliveries[0].Payload["data"].(map[string]interface{}); data["user_guid"] != guid {
+		t.Fatalf("unexpected payload: %+v", deliveries[0].Payload)
+	}
+
+	path := "/webhooks/deliveries/" + strconv.FormatInt(deliveries[0].ID, 10)
+	delivery := getDelivery(t, s, path)
+	if len(delivery.AttemptLog) != 3 {
+		t.Fatalf("got %d logged attempts, want 3", len(delivery.AttemptLog))
+	}
+	for _, v := range delivery.AttemptLog {
+		if v.ResponseStatus != http.StatusServiceUnavailable || v.Error == "" || v.Replay ||
+			v.URL != s.subscription.URL || v.RequestHeaders[webhook.HeaderSignature] == "" {
+			t.Fatalf("unexpected attempt: %+v", v)
+		}
+	}
+
+	//receiver is fixed and operator replays delivery
+	s.webhooks.fail(webhook2.EventIPChanged, 0)
+	status, body := s.do(t, request{method: http.MethodPost, path: path + "/replay", headers: auth})
+	var replayed webhookDeliveryAttempt
+	if status != http.StatusOK || json.Unmarshal(body, &replayed) != nil {
+		t.Fatalf("replay: status %d, body %s", status, body)
+	}
+	if !replayed.Replay || replayed.ResponseStatus != http.StatusOK || replayed.Error != "" {
+		t.Fatalf("unexpected replay attempt: %+v", replayed)
+	}
+	if events := s.webhooks.events(webhook2.EventIPChanged); len(events) != 1 || events[0].Data["user_guid"] != guid {
+		t.Fatalf("unexpected webhooks after replay: %+v", events)
+	}
+
+	delivery = getDelivery(t, s, path)
+	if delivery.Status != "delivered" || delivery.Attempts != 3 || len(delivery.AttemptLog) != 4 || delivery.LastError != "" {
+		t.Fatalf("unexpected delivery after replay: %+v", delivery)
+	}
+
+	if status, _ := s.do(t, request{method: http.MethodPost, path: "/webhooks/deliveries/999999/replay", headers: auth}); status != http.StatusNotFound {
+		t.Fatalf("replay of unknown delivery: status %d, want %d", status, http.StatusNotFound)
+	}
+}
+
+func getDelivery(t *testing.T, s *server, path string) webhookDelivery {
+	t.Helper()
+	status, body := s.do(t, request{method: http.MethodGet, path: path, headers: map[string]string{"Authorization": adminAuth}})
+	var delivery webhookDelivery
+	if status != http.StatusOK || json.Unmarshal(body, &delivery) != nil {
+		t.Fatalf("get delivery: status %d, body %s", status, body)
+	}
+	return delivery
+}
+
+func TestWebhookInternalTargetsRejected(t *testing.T) {
+	ctx := context.Background()
+	ws := webhook.NewWService(&webhook.Config{}, outbox.NewMemoryRepository(), subscription.NewMemoryRepository(),
+		attempt.NewMemoryRepository(), external.NewClient(nil))
+
+	for _, v := range []string{
+		"http://example.com/hook",
+		"https://localhost/hook",
+		"https://127.0.0.1/hook",
+		"https://10.1.2.3/hook",
+		"https://192.168.0.10:8443/hook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[::1]/hook",
+		"https://[fe80::1]/hook",
+		"https://[::ffff:127.0.0.1]/hook",
+	} {
+		if _, err := ws.CreateSubscription(ctx, v, webhook2.EventTypes); !errors.Is(err, webhook.ErrInvalidSubscription) {
+			t.Errorf("url %s: error %v, want %v", v, err, webhook.ErrInvalidSubscription)
+		}
+	}
+	if _, err := ws.CreateSubscription(ctx, "https://example.com/hook", webhook2.EventTypes); err != nil {
+		t.Fatalf("public https url: %s", err)
+	}
+
+	//names resolved to internal addresses are refused on connect
+	stub := newWebhookStub(t)
+	status, err := external.NewClient(external.NewHTTPClient(time.Second, false)).SendWebhook(stub.URL, []byte("{}"), http.Header{})
+	if !errors.Is(err, external.ErrInternalAddress) || status != 0 {
+		t.Fatalf("send to loopback: status %d, error %v", status, err)
+	}
+}
+
+func TestWebhookReplayRefusedWhileScheduled(t *testing.T) {
+	ctx := context.Background()
+	stub := newWebhookStub(t)
+	ws := webhook.NewWService(&webhook.Config{
+		Lease:                time.Minute,
+		MaxAttempts:          3,
+		InitialBackoff:       time.Hour,
+		MaxBackoff:           time.Hour,
+		AllowInsecureTargets: true,
+	}, outbox.NewMemoryRepository(), subscription.NewMemoryRepository(), attempt.NewMemoryRepository(), external.NewClient(nil))
+	sub, err := ws.CreateSubscription(ctx, stub.URL, []string{webhook2.EventIPChanged})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//failed delivery waits for retry and belongs to dispatcher
+	stub.fail(webhook2.EventIPChanged, 1)
+	if err = ws.Publish(ctx, webhook2.EventIPChanged, webhook2.IPChanged{UserGuid: "first"}); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := ws.Dispatch(ctx); err != nil || n != 1 {
+		t.Fatalf("dispatch: %d events, error %v", n, err)
+	}
+	scheduled, err := ws.Deliveries(ctx, webhook.DeliveryFilter{})
+	if err != nil || len(scheduled) != 1 || scheduled[0].Status != "pending" {
+		t.Fatalf("unexpected deliveries: %+v, error %v", scheduled, err)
+	}
+	if _, err = ws.Replay(ctx, scheduled[0].ID); !errors.Is(err, webhook.ErrDeliveryInProgress) {
+		t.Fatalf("replay of scheduled delivery: error %v, want %v", err, webhook.ErrDeliveryInProgress)
+	}
+
+	//due delivery is claimed by replay, so dispatcher doesn't send it again
+	if err = ws.Publish(ctx, webhook2.EventIPChanged, webhook2.IPChanged{UserGuid: "second"}); err != nil {
+		t.Fatal(err)
+	}
+	due, err := ws.Deliveries(ctx, webhook.DeliveryFilter{Limit: 1})
+	if err != nil || len(due) != 1 {
+		t.Fatalf("unexpected deliveries: %+v, error %v", due, err)
+	}
+	if a, err := ws.Replay(ctx, due[0].ID); err != nil || a.Error != "" {
+		t.Fatalf("replay of due delivery: attempt %+v, error %v", a, err)
+	}
+	if n, err := ws.Dispatch(ctx); err != nil || n != 0 {
+		t.Fatalf("dispatch after replay: %d events, error %v", n, err)
+	}
+	if events := stub.events(webhook2.EventIPChanged); len(events) != 1 || events[0].Data["user_guid"] != "second" {
+		t.Fatalf("unexpected webhooks: %+v", events)
+	}
+
+	//inactive subscription doesn't receive replays as it doesn't receive deliveries
+	active := false
+	if _, err = ws.UpdateSubscription(ctx, sub.ID, webhook.SubscriptionUpdate{Active: &active}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ws.Replay(ctx, due[0].ID); !errors.Is(err, webhook.ErrSubscriptionInactive) {
+		t.Fatalf("replay to inactive subscription: error %v, want %v", err, webhook.ErrSubscriptionInactive)
+	}
+}
@@ -2,16 +2,20 @@ package api
 
 import (
 	"medods/api"
+	"medods/internal/service/audit"
 	"medods/internal/service/token"
 	"medods/internal/service/user"
+	"medods/internal/service/webhook"
 )
 
 type ApiHandler struct {
 	api.StrictServerInterface
-	ts *token.TokenService
-	us *user.UserService
+	ts    *token.TokenService
+	us    *user.UserService
+	ws    *webhook.WebhookService
+	audit *audit.AuditLogger
 }
 
-func NewApiHandler(ts *token.TokenService, us *user.UserService) *ApiHandler {
-	return &ApiHandler{ts: ts, us: us}
+func NewApiHandler(ts *token.TokenService, us *user.UserService, ws *webhook.WebhookService, al *audit.AuditLogger) *ApiHandler {
+	return &ApiHandler{ts: ts, us: us, ws: ws, audit: al}
 }
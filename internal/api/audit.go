@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"io"
+	"medods/api"
+	"medods/internal/domain/audit"
+	"time"
+)
+
+func (h *ApiHandler) GetAuditEvents(ctx context.Context, request api.GetAuditEventsRequestObject) (api.GetAuditEventsResponseObject, error) {
+	f := auditFilter(request.Params.UserGuid, request.Params.Type, request.Params.From, request.Params.To)
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 {
+			msg := "limit must be positive"
+			return api.GetAuditEvents400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+		}
+		f.Limit = uint64(*request.Params.Limit)
+	}
+	if request.Params.Offset != nil {
+		if *request.Params.Offset < 0 {
+			msg := "offset must not be negative"
+			return api.GetAuditEvents400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+		}
+		f.Offset = uint64(*request.Params.Offset)
+	}
+
+	events, err := h.audit.Events(ctx, f)
+	if err != nil {
+		msg := "error occurred while proccessing request"
+		return api.GetAuditEvents500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	response := make(api.GetAuditEvents200JSONResponse, len(events))
+	for i, v := range events {
+		response[i] = api.AuditEvent{
+			Id:         v.ID,
+			OccurredAt: v.OccurredAt,
+			Type:       api.AuditEventType(v.Type),
+			Outcome:    api.AuditEventOutcome(v.Outcome),
+			UserGuid:   v.UserGuid,
+			SessionId:  v.SessionID,
+			Ip:         v.IP,
+			UserAgent:  v.UserAgent,
+			Reason:     v.Reason,
+		}
+	}
+	return response, nil
+}
+
+func (h *ApiHandler) GetAuditEventsExport(ctx context.Context, request api.GetAuditEventsExportRequestObject) (api.GetAuditEventsExportResponseObject, error) {
+	f := auditFilter(request.Params.UserGuid, request.Params.Type, request.Params.From, request.Params.To)
+
+	//events are streamed while they are read page by page
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(h.audit.Export(ctx, f, pw))
+	}()
+	return api.GetAuditEventsExport200ApplicationxNdjsonResponse{Body: pr}, nil
+}
+
+func auditFilter(userGuid *string, eventType *api.AuditEventType, from *time.Time, to *time.Time) audit.Filter {
+	var f audit.Filter
+	if userGuid != nil {
+		f.UserGuid = *userGuid
+	}
+	if eventType != nil {
+		f.Type = string(*eventType)
+	}
+	if from != nil {
+		f.From = *from
+	}
+	if to != nil {
+		f.To = *to
+	}
+	return f
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"net/netip"
+	"strings"
+)
+
+const headerXRealIP = "X-Real-IP"
+
+// IPResolver resolve address of client which may be behind trusted proxies
+type IPResolver struct {
+	trusted []netip.Prefix
+}
+
+func NewIPResolver(conf *Config) (*IPResolver, error) {
+	r := &IPResolver{}
+	for _, v := range strings.Split(conf.TrustedProxies, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		prefix, err := parsePrefix(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", v, err)
+		}
+		r.trusted = append(r.trusted, prefix)
+	}
+	return r, nil
+}
+
+// ClientIP return address of client. Forwarding headers are used only when peer is trusted proxy,
+// otherwise they may be forged by client. Forwarded has priority over X-Forwarded-For and X-Real-IP
+func (r *IPResolver) ClientIP(c *fiber.Ctx) string {
+	peer, ok := netip.AddrFromSlice(c.Context().RemoteIP())
+	if !ok {
+		return c.IP()
+	}
+	peer = peer.Unmap()
+	if !r.isTrusted(peer) {
+		return peer.String()
+	}
+
+	hops := forwardedHops(c)
+	if len(hops) == 0 {
+		if addr, ok := parseHop(c.Get(headerXRealIP)); ok {
+			return addr.String()
+		}
+		return peer.String()
+	}
+	//every proxy appends address of its peer, so chain is walked from the nearest hop
+	//and the first address which isn't trusted proxy is client
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, ok := parseHop(hops[i])
+		if !ok {
+			//unknown or obfuscated node, the last known hop is reported
+			break
+		}
+		client = addr
+		if !r.isTrusted(addr) {
+			break
+		}
+	}
+	return client.String()
+}
+
+func (r *IPResolver) isTrusted(addr netip.Addr) bool {
+	for _, v := range r.trusted {
+		if v.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedHops return client addresses from RFC 7239 Forwarded or, if it is absent, from X-Forwarded-For
+// in order they were appended by proxies. Multiple headers are joined as one list
+func forwardedHops(c *fiber.Ctx) []string {
+	hops := make([]string, 0)
+	for _, header := range c.Request().Header.PeekAll(fiber.HeaderForwarded) {
+		for _, element := range strings.Split(string(header), ",") {
+			//element without for parameter is kept as unknown hop
+			var hop string
+			for _, pair := range strings.Split(element, ";") {
+				k, v, _ := strings.Cut(strings.TrimSpace(pair), "=")
+				if strings.EqualFold(k, "for") {
+					hop = strings.Trim(v, `"`)
+				}
+			}
+			hops = append(hops, hop)
+		}
+	}
+	if len(hops) > 0 {
+		return hops
+	}
+	for _, header := range c.Request().Header.PeekAll(fiber.HeaderXForwardedFor) {
+		hops = append(hops, strings.Split(string(header), ",")...)
+	}
+	return hops
+}
+
+// parseHop parse address of hop which may have port and brackets, e.g. "[2001:db8::1]:4711"
+func parseHop(v string) (netip.Addr, bool) {
+	v = strings.TrimSpace(v)
+	if addrPort, err := netip.ParseAddrPort(v); err == nil {
+		return addrPort.Addr().WithZone("").Unmap(), true
+	}
+	addr, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(v, "["), "]"))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.WithZone("").Unmap(), true
+}
+
+// parsePrefix parse CIDR or single address
+func parsePrefix(v string) (netip.Prefix, error) {
+	if strings.Contains(v, "/") {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
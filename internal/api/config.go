@@ -0,0 +1,22 @@
+package api
+
+import (
+	"fmt"
+	"go.dataddo.com/env"
+	"log"
+)
+
+type Config struct {
+	// TrustedProxies addresses and CIDRs of proxies in format "10.0.0.0/8,192.168.1.10",
+	// forwarding headers are taken into account only in requests from them
+	TrustedProxies string `env:"TRUSTED_PROXIES"`
+}
+
+func NewConfig() (*Config, error) {
+	var config Config
+	if err := env.Load(&config, "HTTP_"); err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the http config: %v", err)
+	}
+	log.Printf("http config was loaded successfully")
+	return &config, nil
+}
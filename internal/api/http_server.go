@@ -2,13 +2,20 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"log"
 	"medods/api"
+	"medods/internal/domain/request"
 	token3 "medods/internal/domain/token"
+	"medods/internal/service/audit"
+	"medods/internal/service/client"
 	"medods/internal/service/token"
 	"medods/internal/service/user"
+	"medods/internal/service/webhook"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -17,26 +24,41 @@ const (
 	UserGuidKey     string = "USER_GUID"
 	UserAgentKey    string = "USER_AGENT"
 	IPKey           string = "IP_KEY"
+	ClientIDKey     string = "CLIENT_ID"
+	SessionIDKey    string = "SESSION_ID"
 )
 
-func StartHttpServer(ts *token.TokenService, us *user.UserService) {
-	apiHandler := NewApiHandler(ts, us)
+func StartHttpServer(ts *token.TokenService, us *user.UserService, ws *webhook.WebhookService, al *audit.AuditLogger, cs *client.ClientService, ipr *IPResolver) {
+	app := NewApp(ts, us, ws, al, cs, ipr)
+	address := fmt.Sprintf(":8080")
+	log.Fatal(app.Listen(address))
+}
+
+// NewApp build fiber app with all routes and middlewares
+func NewApp(ts *token.TokenService, us *user.UserService, ws *webhook.WebhookService, al *audit.AuditLogger, cs *client.ClientService, ipr *IPResolver) *fiber.App {
+	apiHandler := NewApiHandler(ts, us, ws, al)
 	app := fiber.New(
 		fiber.Config{
 			// Prefork:      true,
 			ReadTimeout:  10 * time.Second,
 			WriteTimeout: 10 * time.Second,
 			IdleTimeout:  10 * time.Second,
+			//request values outlive handlers in audit log and sessions
+			Immutable: true,
 		},
 	)
-	app.Use(sendFiberContext())
+	app.Use(sendFiberContext(ipr))
 	userGroup := app.Group("user")
 	userGroup.Use(authMiddleware(ts))
+	app.Use("/authorize", authorizeClientMiddleware(cs))
+	app.Use("/introspect", clientAuthMiddleware(cs))
+	app.Use("/revoke", clientAuthMiddleware(cs))
+	app.Use("/webhooks", adminAuthMiddleware(cs))
+	app.Use("/audit", adminAuthMiddleware(cs))
 	app.Static("/swagger", "./swagger-ui")
 	app.Static("/api", "./api")
 	api.RegisterHandlers(app, api.NewStrictHandler(apiHandler, nil))
-	address := fmt.Sprintf(":8080")
-	log.Fatal(app.Listen(address))
+	return app
 }
 
 func authMiddleware(tokenService *token.TokenService) fiber.Handler {
@@ -54,15 +76,92 @@ func authMiddleware(tokenService *token.TokenService) fiber.Handler {
 			return c.Status(401).JSON(fiber.Map{"error": "unauthorized"})
 		}
 		c.Locals(UserGuidKey, dst.Subject)
+		c.Locals(SessionIDKey, dst.SessionID)
+		return c.Next()
+	}
+}
+
+// clientAuthMiddleware authenticate client by HTTP Basic credentials or client_id and client_secret form fields
+func clientAuthMiddleware(clientService *client.ClientService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		clientID, secret, ok := basicCredentials(c.Get("Authorization"))
+		if !ok {
+			clientID, secret = c.FormValue("client_id"), c.FormValue("client_secret")
+		}
+		if clientID == "" || clientService.Authenticate(clientID, secret) != nil {
+			c.Set("WWW-Authenticate", `Basic realm="token"`)
+			return c.Status(401).JSON(fiber.Map{"error": "invalid_client"})
+		}
+		c.Locals(ClientIDKey, clientID)
+		return c.Next()
+	}
+}
+
+// authorizeClientMiddleware authenticate client which requests tokens of user by HTTP Basic credentials.
+// Authentication is optional, but client_id without credentials or other than authenticated one is refused,
+// so tokens can't be bound to client by anyone
+func authorizeClientMiddleware(clientService *client.ClientService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		requested := c.Query("client_id")
+		clientID, secret, ok := basicCredentials(c.Get("Authorization"))
+		if !ok && requested == "" {
+			return c.Next()
+		}
+		if !ok || clientID == "" || clientService.Authenticate(clientID, secret) != nil || (requested != "" && requested != clientID) {
+			c.Set("WWW-Authenticate", `Basic realm="token"`)
+			return c.Status(401).JSON(fiber.Map{"error": "invalid_client"})
+		}
+		c.Locals(ClientIDKey, clientID)
 		return c.Next()
 	}
 }
 
-func sendFiberContext() fiber.Handler {
+// adminAuthMiddleware authenticate administrator by HTTP Basic credentials, credentials of clients aren't accepted
+func adminAuthMiddleware(clientService *client.ClientService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		adminID, secret, ok := basicCredentials(c.Get("Authorization"))
+		if !ok || adminID == "" || clientService.AuthenticateAdmin(adminID, secret) != nil {
+			c.Set("WWW-Authenticate", `Basic realm="admin"`)
+			return c.Status(401).JSON(fiber.Map{"error": "unauthorized"})
+		}
+		return c.Next()
+	}
+}
+
+// basicCredentials parse Authorization header of HTTP Basic scheme,
+// credentials are form-urlencoded as RFC 6749 requires
+func basicCredentials(header string) (string, string, bool) {
+	encoded, ok := strings.CutPrefix(header, "Basic ")
+	if !ok {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+	rawID, rawSecret, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", false
+	}
+	clientID, err := url.QueryUnescape(rawID)
+	if err != nil {
+		return "", "", false
+	}
+	secret, err := url.QueryUnescape(rawSecret)
+	if err != nil {
+		return "", "", false
+	}
+	return clientID, secret, true
+}
+
+func sendFiberContext(ipr *IPResolver) fiber.Handler {
 	return func(c *fiber.Ctx) error {
+		ip := ipr.ClientIP(c)
 		ctx := context.WithValue(c.UserContext(), FiberContextKey, c)
+		//metadata of request is recorded by services in audit log
+		ctx = request.WithData(ctx, request.RequestData{UserAgent: c.Get("User-Agent"), IP: ip})
 		c.SetUserContext(ctx)
-		c.Locals(IPKey, c.IP())
+		c.Locals(IPKey, ip)
 		return c.Next()
 	}
 }
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"medods/api"
+	"medods/internal/service/token"
+)
+
+func (h *ApiHandler) PostIntrospect(ctx context.Context, request api.PostIntrospectRequestObject) (api.PostIntrospectResponseObject, error) {
+	if request.Body == nil || request.Body.Token == "" {
+		msg := "token is required"
+		return api.PostIntrospect400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+	}
+	var hint string
+	if request.Body.TokenTypeHint != nil {
+		hint = string(*request.Body.TokenTypeHint)
+	}
+	res, err := h.ts.Introspect(ctx, request.Body.Token, hint)
+	if err != nil {
+		msg := "internal server error"
+		return api.PostIntrospect500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	if !res.Active {
+		return api.PostIntrospect200JSONResponse{Active: false}, nil
+	}
+
+	exp := res.ExpiresAt.Unix()
+	iat := res.IssuedAt.Unix()
+	response := api.PostIntrospect200JSONResponse{
+		Active:    true,
+		Sub:       optional(res.Subject),
+		ClientId:  optional(res.ClientID),
+		Sid:       optional(res.SessionID),
+		Jti:       optional(res.JWTID),
+		Iss:       optional(res.Issuer),
+		Exp:       &exp,
+		Iat:       &iat,
+		TokenType: optional(res.TokenType),
+		UserAgent: optional(res.UserAgent),
+		Ip:        optional(res.IP),
+	}
+	if len(res.Audience) > 0 {
+		response.Aud = &res.Audience
+	}
+	return response, nil
+}
+
+func (h *ApiHandler) PostRevoke(ctx context.Context, request api.PostRevokeRequestObject) (api.PostRevokeResponseObject, error) {
+	if request.Body == nil || request.Body.Token == "" {
+		msg := "token is required"
+		return api.PostRevoke400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+	}
+	var hint string
+	if request.Body.TokenTypeHint != nil {
+		hint = string(*request.Body.TokenTypeHint)
+	}
+	fCtx, err := getFiberContext(ctx)
+	if err != nil {
+		msg := "internal server error"
+		return api.PostRevoke500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	clientID, _ := fCtx.Locals(ClientIDKey).(string)
+	err = h.ts.Revoke(ctx, clientID, request.Body.Token, hint)
+	switch {
+	case errors.Is(err, token.ErrClientMismatch):
+		msg := "unauthorized_client: " + err.Error()
+		return api.PostRevoke400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "internal server error"
+		return api.PostRevoke500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	return api.PostRevoke200Response{}, nil
+}
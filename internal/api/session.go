@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"medods/api"
+	"medods/internal/service/token"
+)
+
+func (h *ApiHandler) GetUserSessions(ctx context.Context, request api.GetUserSessionsRequestObject) (api.GetUserSessionsResponseObject, error) {
+	userGuid, sessionID, err := getAuthorizedUser(ctx)
+	if err != nil {
+		msg := err.Error()
+		return api.GetUserSessions500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, nil
+	}
+	sessions, err := h.ts.Sessions(ctx, userGuid)
+	if err != nil {
+		msg := "error occurred while proccessing request"
+		return api.GetUserSessions500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	response := make(api.GetUserSessions200JSONResponse, len(sessions))
+	for i, v := range sessions {
+		response[i] = api.Session{
+			Id:              v.ID,
+			CreatedAt:       v.CreatedAt,
+			LastRefreshedAt: v.LastRefreshedAt,
+			Ip:              v.IP,
+			UserAgent:       v.UserAgent,
+			Current:         v.ID == sessionID,
+		}
+	}
+	return response, nil
+}
+
+func (h *ApiHandler) DeleteUserSessionsId(ctx context.Context, request api.DeleteUserSessionsIdRequestObject) (api.DeleteUserSessionsIdResponseObject, error) {
+	userGuid, _, err := getAuthorizedUser(ctx)
+	if err != nil {
+		msg := err.Error()
+		return api.DeleteUserSessionsId500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, nil
+	}
+	err = h.ts.RevokeSession(ctx, userGuid, request.Id)
+	switch {
+	case errors.Is(err, token.ErrSessionNotFound):
+		msg := err.Error()
+		return api.DeleteUserSessionsId404JSONResponse{N404JSONResponse: api.N404JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "error occurred while proccessing request"
+		return api.DeleteUserSessionsId500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	return api.DeleteUserSessionsId200Response{}, nil
+}
+
+func (h *ApiHandler) PostUserLogoutAll(ctx context.Context, request api.PostUserLogoutAllRequestObject) (api.PostUserLogoutAllResponseObject, error) {
+	userGuid, _, err := getAuthorizedUser(ctx)
+	if err != nil {
+		msg := err.Error()
+		return api.PostUserLogoutAll500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, nil
+	}
+	if err = h.ts.RevokeAllSessions(ctx, userGuid); err != nil {
+		msg := "error occurred while proccessing request"
+		return api.PostUserLogoutAll500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	return api.PostUserLogoutAll200Response{}, nil
+}
+
+// getAuthorizedUser return user guid and session id stored by authMiddleware
+func getAuthorizedUser(ctx context.Context) (string, string, error) {
+	fCtx, err := getFiberContext(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	userGuid, ok := fCtx.Locals(UserGuidKey).(string)
+	if !ok || userGuid == "" {
+		return "", "", errors.New("error occurred while proccessing request")
+	}
+	sessionID, _ := fCtx.Locals(SessionIDKey).(string)
+	return userGuid, sessionID, nil
+}
@@ -6,8 +6,12 @@ import (
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"medods/api"
+	"medods/internal/domain/oidc"
 	request2 "medods/internal/domain/request"
 	"medods/internal/domain/token"
+	token2 "medods/internal/service/token"
+	"slices"
+	"strings"
 )
 
 func (h *ApiHandler) PostAuthorize(ctx context.Context, request api.PostAuthorizeRequestObject) (api.PostAuthorizeResponseObject, error) {
@@ -25,14 +29,51 @@ func (h *ApiHandler) PostAuthorize(ctx context.Context, request api.PostAuthoriz
 		UserAgent: request.Params.UserAgent,
 		IP:        ip.(string),
 	}
+	//client is trusted only when it is authenticated by middleware
+	if clientID, ok := fCtx.Locals(ClientIDKey).(string); ok {
+		reqData.ClientID = clientID
+	}
 	guid := request.Params.Guid
+	//id token is issued only for openid scope, check signing key before session is created
+	openID := request.Params.Scope != nil && slices.Contains(strings.Fields(*request.Params.Scope), token2.ScopeOpenID)
+	if openID {
+		err = h.ts.CheckIDTokenSigner()
+		switch {
+		case errors.Is(err, token2.ErrSymmetricSigningKey):
+			msg := fmt.Sprintf("invalid_scope: %s", err.Error())
+			return api.PostAuthorize400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, err
+		case err != nil:
+			msg := "internal server error"
+			return api.PostAuthorize500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+		}
+	}
 	tokenPairs, err := h.us.Authorize(ctx, guid, reqData)
 
 	if err != nil {
 		msg := fmt.Sprintf("error occurred while proccessing request: %s", err.Error())
 		return api.PostAuthorize400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, err
 	}
-	return api.PostAuthorize200JSONResponse{AccessToken: tokenPairs.AccessToken, RefreshToken: tokenPairs.RefreshToken}, nil
+	response := api.PostAuthorize200JSONResponse{
+		AccessToken:      tokenPairs.AccessToken,
+		RefreshToken:     tokenPairs.RefreshToken,
+		ExpiresIn:        &tokenPairs.ExpiresIn,
+		RefreshExpiresIn: &tokenPairs.RefreshExpiresIn,
+	}
+
+	if openID {
+		params := oidc.IDTokenParams{}
+		if request.Params.Nonce != nil {
+			params.Nonce = *request.Params.Nonce
+		}
+		params.Audience = reqData.ClientID
+		idToken, err := h.ts.BuildIDToken(guid, tokenPairs.AccessToken, params)
+		if err != nil {
+			msg := "internal server error"
+			return api.PostAuthorize500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+		}
+		response.IdToken = &idToken
+	}
+	return response, nil
 }
 
 func (h *ApiHandler) PostRefresh(ctx context.Context, request api.PostRefreshRequestObject) (api.PostRefreshResponseObject, error) {
@@ -52,11 +93,20 @@ func (h *ApiHandler) PostRefresh(ctx context.Context, request api.PostRefreshReq
 	}
 	var tokenPairs = token.TokensPair{AccessToken: request.Body.AccessToken, RefreshToken: request.Body.RefreshToken}
 	err = h.ts.Refresh(ctx, &tokenPairs, reqData)
-	if err != nil {
+	switch {
+	case errors.Is(err, token2.ErrRefreshConflict):
+		msg := err.Error()
+		return api.PostRefresh409JSONResponse{N409JSONResponse: api.N409JSONResponse{Message: &msg}}, nil
+	case err != nil:
 		msg := fmt.Sprintf("error occurred while proccessing request: %s", err.Error())
 		return api.PostRefresh400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, err
 	}
-	return api.PostRefresh200JSONResponse{AccessToken: tokenPairs.AccessToken, RefreshToken: tokenPairs.RefreshToken}, nil
+	return api.PostRefresh200JSONResponse{
+		AccessToken:      tokenPairs.AccessToken,
+		RefreshToken:     tokenPairs.RefreshToken,
+		ExpiresIn:        &tokenPairs.ExpiresIn,
+		RefreshExpiresIn: &tokenPairs.RefreshExpiresIn,
+	}, nil
 }
 
 func (h *ApiHandler) GetUserGuid(ctx context.Context, request api.GetUserGuidRequestObject) (api.GetUserGuidResponseObject, error) {
@@ -74,6 +124,20 @@ func (h *ApiHandler) GetUserGuid(ctx context.Context, request api.GetUserGuidReq
 	return api.GetUserGuid200JSONResponse{UserGuid: &userGuidStr}, nil
 }
 
+func (h *ApiHandler) GetUserUserinfo(ctx context.Context, request api.GetUserUserinfoRequestObject) (api.GetUserUserinfoResponseObject, error) {
+	fCtx, err := getFiberContext(ctx)
+	if err != nil {
+		msg := err.Error()
+		return api.GetUserUserinfo500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, nil
+	}
+	userGuid, ok := fCtx.Locals(UserGuidKey).(string)
+	if !ok || userGuid == "" {
+		msg := fmt.Sprintf("error occurred while proccessing request")
+		return api.GetUserUserinfo500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, nil
+	}
+	return api.GetUserUserinfo200JSONResponse{Sub: userGuid}, nil
+}
+
 func (h *ApiHandler) PostUserLogout(ctx context.Context, request api.PostUserLogoutRequestObject) (api.PostUserLogoutResponseObject, error) {
 	err := h.ts.BlockToken(ctx, request.Params.Authorization)
 	if err != nil {
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"medods/api"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/service/webhook"
+)
+
+func (h *ApiHandler) GetWebhooksSubscriptions(ctx context.Context, request api.GetWebhooksSubscriptionsRequestObject) (api.GetWebhooksSubscriptionsResponseObject, error) {
+	subs, err := h.ws.Subscriptions(ctx)
+	if err != nil {
+		msg := "error occurred while proccessing request"
+		return api.GetWebhooksSubscriptions500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	response := make(api.GetWebhooksSubscriptions200JSONResponse, len(subs))
+	for i := range subs {
+		response[i] = toApiSubscription(&subs[i], false)
+	}
+	return response, nil
+}
+
+func (h *ApiHandler) PostWebhooksSubscriptions(ctx context.Context, request api.PostWebhooksSubscriptionsRequestObject) (api.PostWebhooksSubscriptionsResponseObject, error) {
+	if request.Body == nil {
+		msg := "url and event_types are required"
+		return api.PostWebhooksSubscriptions400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+	}
+	sub, err := h.ws.CreateSubscription(ctx, request.Body.Url, fromApiEventTypes(request.Body.EventTypes))
+	switch {
+	case errors.Is(err, webhook.ErrInvalidSubscription):
+		msg := err.Error()
+		return api.PostWebhooksSubscriptions400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "error occurred while proccessing request"
+		return api.PostWebhooksSubscriptions500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	//secret is shown only once, receiver must store it for verification of signatures
+	return api.PostWebhooksSubscriptions201JSONResponse(toApiSubscription(sub, true)), nil
+}
+
+func (h *ApiHandler) GetWebhooksSubscriptionsId(ctx context.Context, request api.GetWebhooksSubscriptionsIdRequestObject) (api.GetWebhooksSubscriptionsIdResponseObject, error) {
+	sub, err := h.ws.Subscription(ctx, request.Id)
+	switch {
+	case errors.Is(err, webhook.ErrSubscriptionNotFound):
+		msg := err.Error()
+		return api.GetWebhooksSubscriptionsId404JSONResponse{N404JSONResponse: api.N404JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "error occurred while proccessing request"
+		return api.GetWebhooksSubscriptionsId500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	return api.GetWebhooksSubscriptionsId200JSONResponse(toApiSubscription(sub, false)), nil
+}
+
+func (h *ApiHandler) PatchWebhooksSubscriptionsId(ctx context.Context, request api.PatchWebhooksSubscriptionsIdRequestObject) (api.PatchWebhooksSubscriptionsIdResponseObject, error) {
+	if request.Body == nil {
+		msg := "body is required"
+		return api.PatchWebhooksSubscriptionsId400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+	}
+	upd := webhook.SubscriptionUpdate{URL: request.Body.Url, Active: request.Body.Active}
+	if request.Body.EventTypes != nil {
+		upd.EventTypes = fromApiEventTypes(*request.Body.EventTypes)
+	}
+	sub, err := h.ws.UpdateSubscription(ctx, request.Id, upd)
+	switch {
+	case errors.Is(err, webhook.ErrInvalidSubscription):
+		msg := err.Error()
+		return api.PatchWebhooksSubscriptionsId400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+	case errors.Is(err, webhook.ErrSubscriptionNotFound):
+		msg := err.Error()
+		return api.PatchWebhooksSubscriptionsId404JSONResponse{N404JSONResponse: api.N404JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "error occurred while proccessing request"
+		return api.PatchWebhooksSubscriptionsId500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	return api.PatchWebhooksSubscriptionsId200JSONResponse(toApiSubscription(sub, false)), nil
+}
+
+func (h *ApiHandler) DeleteWebhooksSubscriptionsId(ctx context.Context, request api.DeleteWebhooksSubscriptionsIdRequestObject) (api.DeleteWebhooksSubscriptionsIdResponseObject, error) {
+	err := h.ws.DeleteSubscription(ctx, request.Id)
+	switch {
+	case errors.Is(err, webhook.ErrSubscriptionNotFound):
+		msg := err.Error()
+		return api.DeleteWebhooksSubscriptionsId404JSONResponse{N404JSONResponse: api.N404JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "error occurred while proccessing request"
+		return api.DeleteWebhooksSubscriptionsId500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	return api.DeleteWebhooksSubscriptionsId200Response{}, nil
+}
+
+func (h *ApiHandler) GetWebhooksDeliveries(ctx context.Context, request api.GetWebhooksDeliveriesRequestObject) (api.GetWebhooksDeliveriesResponseObject, error) {
+	var f webhook.DeliveryFilter
+	if request.Params.EventType != nil {
+		f.EventType = string(*request.Params.EventType)
+	}
+	if request.Params.Status != nil {
+		f.Status = string(*request.Params.Status)
+	}
+	if request.Params.SubscriptionId != nil {
+		f.SubscriptionID = *request.Params.SubscriptionId
+	}
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 {
+			msg := "limit must be positive"
+			return api.GetWebhooksDeliveries400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+		}
+		f.Limit = uint64(*request.Params.Limit)
+	}
+	if request.Params.Offset != nil {
+		if *request.Params.Offset < 0 {
+			msg := "offset must not be negative"
+			return api.GetWebhooksDeliveries400JSONResponse{N400JSONResponse: api.N400JSONResponse{Message: &msg}}, nil
+		}
+		f.Offset = uint64(*request.Params.Offset)
+	}
+
+	deliveries, err := h.ws.Deliveries(ctx, f)
+	if err != nil {
+		msg := "error occurred while proccessing request"
+		return api.GetWebhooksDeliveries500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	response := make(api.GetWebhooksDeliveries200JSONResponse, len(deliveries))
+	for i := range deliveries {
+		response[i] = toApiDelivery(&deliveries[i])
+	}
+	return response, nil
+}
+
+func (h *ApiHandler) GetWebhooksDeliveriesId(ctx context.Context, request api.GetWebhooksDeliveriesIdRequestObject) (api.GetWebhooksDeliveriesIdResponseObject, error) {
+	delivery, err := h.ws.Delivery(ctx, request.Id)
+	switch {
+	case errors.Is(err, webhook.ErrDeliveryNotFound):
+		msg := err.Error()
+		return api.GetWebhooksDeliveriesId404JSONResponse{N404JSONResponse: api.N404JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "error occurred while proccessing request"
+		return api.GetWebhooksDeliveriesId500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	attempts, err := h.ws.DeliveryAttempts(ctx, request.Id)
+	if err != nil {
+		msg := "error occurred while proccessing request"
+		return api.GetWebhooksDeliveriesId500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+
+	response := toApiDelivery(delivery)
+	attemptLog := make([]api.WebhookDeliveryAttempt, len(attempts))
+	for i := range attempts {
+		attemptLog[i] = toApiDeliveryAttempt(&attempts[i])
+	}
+	response.AttemptLog = &attemptLog
+	return api.GetWebhooksDeliveriesId200JSONResponse(response), nil
+}
+
+func (h *ApiHandler) PostWebhooksDeliveriesIdReplay(ctx context.Context, request api.PostWebhooksDeliveriesIdReplayRequestObject) (api.PostWebhooksDeliveriesIdReplayResponseObject, error) {
+	a, err := h.ws.Replay(ctx, request.Id)
+	switch {
+	case errors.Is(err, webhook.ErrDeliveryNotFound):
+		msg := err.Error()
+		return api.PostWebhooksDeliveriesIdReplay404JSONResponse{N404JSONResponse: api.N404JSONResponse{Message: &msg}}, nil
+	case errors.Is(err, webhook.ErrSubscriptionNotFound):
+		msg := "subscription of delivery was deleted"
+		return api.PostWebhooksDeliveriesIdReplay409JSONResponse{N409JSONResponse: api.N409JSONResponse{Message: &msg}}, nil
+	case errors.Is(err, webhook.ErrSubscriptionInactive), errors.Is(err, webhook.ErrDeliveryInProgress):
+		msg := err.Error()
+		return api.PostWebhooksDeliveriesIdReplay409JSONResponse{N409JSONResponse: api.N409JSONResponse{Message: &msg}}, nil
+	case err != nil:
+		msg := "error occurred while proccessing request"
+		return api.PostWebhooksDeliveriesIdReplay500JSONResponse{N500JSONResponse: api.N500JSONResponse{Message: &msg}}, err
+	}
+	return api.PostWebhooksDeliveriesIdReplay200JSONResponse(toApiDeliveryAttempt(a)), nil
+}
+
+func toApiDelivery(d *webhook2.Delivery) api.WebhookDelivery {
+	//payload is json object written by webhook service
+	payload := make(map[string]interface{})
+	_ = json.Unmarshal([]byte(d.Payload), &payload)
+	return api.WebhookDelivery{
+		Id:             d.ID,
+		SubscriptionId: d.SubscriptionID,
+		EventId:        d.EventID,
+		EventType:      api.WebhookEventType(d.EventType),
+		Payload:        payload,
+		Status:         api.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+func toApiDeliveryAttempt(a *webhook2.DeliveryAttempt) api.WebhookDeliveryAttempt {
+	return api.WebhookDeliveryAttempt{
+		Id:             a.ID,
+		Url:            a.URL,
+		RequestHeaders: a.RequestHeaders,
+		ResponseStatus: a.ResponseStatus,
+		LatencyMs:      a.Latency.Milliseconds(),
+		Error:          a.Error,
+		Replay:         a.Replay,
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+func toApiSubscription(sub *webhook2.Subscription, withSecret bool) api.WebhookSubscription {
+	eventTypes := make([]api.WebhookEventType, len(sub.EventTypes))
+	for i, v := range sub.EventTypes {
+		eventTypes[i] = api.WebhookEventType(v)
+	}
+	res := api.WebhookSubscription{
+		Id:         sub.ID,
+		Url:        sub.URL,
+		EventTypes: eventTypes,
+		Active:     sub.Active,
+		CreatedAt:  sub.CreatedAt,
+	}
+	if withSecret {
+		res.Secret = &sub.Secret
+	}
+	return res
+}
+
+func fromApiEventTypes(eventTypes []api.WebhookEventType) []string {
+	res := make([]string, len(eventTypes))
+	for i, v := range eventTypes {
+		res[i] = string(v)
+	}
+	return res
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"medods/api"
+)
+
+func (h *ApiHandler) GetWellKnownOpenidConfiguration(ctx context.Context, request api.GetWellKnownOpenidConfigurationRequestObject) (api.GetWellKnownOpenidConfigurationResponseObject, error) {
+	d := h.ts.Discovery()
+	return api.GetWellKnownOpenidConfiguration200JSONResponse{
+		Issuer:                           d.Issuer,
+		AuthorizationEndpoint:            d.AuthorizationEndpoint,
+		TokenEndpoint:                    d.TokenEndpoint,
+		UserinfoEndpoint:                 d.UserinfoEndpoint,
+		JwksUri:                          d.JwksUri,
+		ScopesSupported:                  d.ScopesSupported,
+		ResponseTypesSupported:           d.ResponseTypesSupported,
+		GrantTypesSupported:              d.GrantTypesSupported,
+		SubjectTypesSupported:            d.SubjectTypesSupported,
+		IdTokenSigningAlgValuesSupported: d.IdTokenSigningAlgValuesSupported,
+		ClaimsSupported:                  d.ClaimsSupported,
+	}, nil
+}
+
+func (h *ApiHandler) GetWellKnownJwksJson(ctx context.Context, request api.GetWellKnownJwksJsonRequestObject) (api.GetWellKnownJwksJsonResponseObject, error) {
+	jwks := h.ts.JWKS()
+	keys := make([]api.JWK, len(jwks))
+	for i, v := range jwks {
+		keys[i] = api.JWK{
+			Kty: v.Kty,
+			Kid: v.Kid,
+			Use: v.Use,
+			Alg: v.Alg,
+			N:   optional(v.N),
+			E:   optional(v.E),
+			Crv: optional(v.Crv),
+			X:   optional(v.X),
+			Y:   optional(v.Y),
+		}
+	}
+	return api.GetWellKnownJwksJson200JSONResponse{Keys: keys}, nil
+}
+
+// optional convert empty string to nil for optional fields of response
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
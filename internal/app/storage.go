@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go.dataddo.com/env"
+	"log"
+	"medods/database"
+	"medods/internal/repository"
+	"medods/internal/repository/attempt"
+	"medods/internal/repository/audit"
+	"medods/internal/repository/blacklist"
+	"medods/internal/repository/outbox"
+	"medods/internal/repository/signingkey"
+	"medods/internal/repository/subscription"
+	token2 "medods/internal/repository/token"
+	user2 "medods/internal/repository/user"
+	audit2 "medods/internal/service/audit"
+	"medods/internal/service/key"
+	"medods/internal/service/revocation"
+	"medods/internal/service/token"
+	"medods/internal/service/user"
+	"medods/internal/service/webhook"
+)
+
+const (
+	StorageDriverPostgres = "postgres"
+	StorageDriverMemory   = "memory"
+)
+
+type StorageConfig struct {
+	// Driver postgres or memory, memory storage runs server without database and loses data on exit
+	Driver string `env:"DRIVER"`
+	// DB config of postgres storage, nil for memory storage
+	DB *database.DBConfig
+}
+
+// NewStorageConfig load config from STORAGE_* variables, database of postgres storage is configured by PG_* variables
+func NewStorageConfig() (*StorageConfig, error) {
+	var config StorageConfig
+	if err := env.Load(&config, "STORAGE_"); err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the storage config: %v", err)
+	}
+	if config.Driver == "" {
+		config.Driver = StorageDriverPostgres
+	}
+	if config.Driver == StorageDriverPostgres {
+		dbConf, err := database.NewConfig()
+		if err != nil {
+			return nil, err
+		}
+		config.DB = dbConf
+	}
+	return &config, nil
+}
+
+// Storage repositories used by services
+type Storage struct {
+	UserRepo         user.UserRepository
+	TokenRepo        token.TokenRepository
+	BlacklistRepo    token.BlacklistRepository
+	SigningKeyRepo   key.SigningKeyRepository
+	Uow              repository.Transactor
+	OutboxRepo       webhook.OutboxRepository
+	SubscriptionRepo webhook.SubscriptionRepository
+	AttemptRepo      webhook.AttemptRepository
+	AuditRepo        audit2.AuditRepository
+	// BlacklistSource notifications about blacklist entries created by other replicas, nil when storage is local
+	BlacklistSource revocation.Source
+
+	db *database.Database
+}
+
+// NewStorage create repositories of storage chosen by driver of config.
+// Pending migrations of postgres storage are applied when DB.AutoMigrate is set
+func NewStorage(ctx context.Context, conf *StorageConfig) (*Storage, error) {
+	switch conf.Driver {
+	case StorageDriverPostgres:
+		if conf.DB == nil {
+			return nil, fmt.Errorf("postgres storage isn't configured")
+		}
+		return newPostgresStorage(ctx, conf.DB)
+	case StorageDriverMemory:
+		log.Printf("in-memory storage is used, data will be lost on exit")
+		return newMemoryStorage(), nil
+	}
+	return nil, fmt.Errorf("unknown storage driver %q", conf.Driver)
+}
+
+// Close close connection of database, memory storage has nothing to close
+func (s *Storage) Close() error {
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func newPostgresStorage(ctx context.Context, dbConf *database.DBConfig) (*Storage, error) {
+	txOpts, err := dbConf.TxOptions()
+	if err != nil {
+		return nil, err
+	}
+	db, err := database.NewDatabase(dbConf)
+	if err != nil {
+		return nil, err
+	}
+	if dbConf.AutoMigrate {
+		if err = migrate(ctx, db); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
+	return &Storage{
+		UserRepo:         user2.NewRepository(db, txOpts),
+		TokenRepo:        token2.NewRepository(db, txOpts),
+		BlacklistRepo:    blacklist.NewRepository(db, txOpts),
+		SigningKeyRepo:   signingkey.NewRepository(db, txOpts),
+		Uow:              repository.NewUnitOfWork(db, txOpts),
+		OutboxRepo:       outbox.NewRepository(db, txOpts),
+		SubscriptionRepo: subscription.NewRepository(db, txOpts),
+		AttemptRepo:      attempt.NewRepository(db, txOpts),
+		AuditRepo:        audit.NewRepository(db, txOpts),
+		BlacklistSource:  blacklist.NewListener(dbConf),
+		db:               db,
+	}, nil
+}
+
+func newMemoryStorage() *Storage {
+	return &Storage{
+		UserRepo:         user2.NewMemoryRepository(),
+		TokenRepo:        token2.NewMemoryRepository(),
+		BlacklistRepo:    blacklist.NewMemoryRepository(),
+		SigningKeyRepo:   signingkey.NewMemoryRepository(),
+		Uow:              repository.NewMemoryUnitOfWork(),
+		OutboxRepo:       outbox.NewMemoryRepository(),
+		SubscriptionRepo: subscription.NewMemoryRepository(),
+		AttemptRepo:      attempt.NewMemoryRepository(),
+		AuditRepo:        audit.NewMemoryRepository(),
+	}
+}
+
+// migrate apply pending migrations on startup when it is enabled by PG_AUTO_MIGRATE
+func migrate(ctx context.Context, db *database.Database) error {
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	res, err := migrator.Up(ctx)
+	if err != nil {
+		return err
+	}
+	for _, v := range res {
+		log.Print(v)
+	}
+	log.Printf("database is migrated, %d migrations applied", len(res))
+	return nil
+}
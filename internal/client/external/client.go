@@ -2,34 +2,40 @@ package external
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
 type ExternalServiceClient struct {
-	client  *http.Client
-	baseUrl string
+	client *http.Client
 }
 
-func NewClient(conf *ExternalClientConfig) *ExternalServiceClient {
-	return &ExternalServiceClient{client: conf.client, baseUrl: conf.BaseUrl}
+func NewClient(client *http.Client) *ExternalServiceClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &ExternalServiceClient{client: client}
 }
 
-func (c *ExternalServiceClient) SendWebhook(path string, payload map[string]string) error {
-	body, err := json.Marshal(payload)
+// SendWebhook post json body with headers to url of receiver and return status of response,
+// status is 0 when response isn't received. Response with status other than 2xx is error
+func (c *ExternalServiceClient) SendWebhook(url string, body []byte, header http.Header) (int, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	url := fmt.Sprintf("%s%s", c.baseUrl, path)
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
-	if err != nil {
-		return err
+	for k, v := range header {
+		req.Header[k] = v
 	}
+	req.Header.Set("Content-Type", "application/json")
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer resp.Body.Close()
-	return nil
+	//receiver must confirm webhook, otherwise it is retried
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook is rejected with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
 }
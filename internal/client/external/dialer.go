@@ -0,0 +1,37 @@
+package external
+
+import (
+	"errors"
+	"fmt"
+	"medods/internal/domain/webhook"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+var ErrInternalAddress = errors.New("receiver address is internal")
+
+// NewHTTPClient return client for webhook receivers. Unless allowInternal is set it refuses to connect
+// to addresses which aren't public, address is checked after resolving, so names and redirects
+// pointing to internal network are refused too
+func NewHTTPClient(timeout time.Duration, allowInternal bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowInternal {
+		dialer := &net.Dialer{Timeout: timeout, Control: publicOnly}
+		transport.DialContext = dialer.DialContext
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse receiver address: %w", err)
+	}
+	if !webhook.PublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrInternalAddress, addrPort.Addr())
+	}
+	return nil
+}
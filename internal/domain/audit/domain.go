@@ -0,0 +1,43 @@
+package audit
+
+import "time"
+
+// types of audit events
+const (
+	EventAuthorize         = "authorize"
+	EventRefresh           = "refresh"
+	EventUserAgentMismatch = "user_agent_mismatch"
+	EventTokenReuse        = "token_reuse"
+	EventLogout            = "logout"
+	EventSessionRevoked    = "session_revoked"
+	EventTokenRevoked      = "token_revoked"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Event record of security relevant action, ip and user agent are of request which caused it
+type Event struct {
+	ID         int64     `json:"id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Type       string    `json:"type"`
+	Outcome    string    `json:"outcome"`
+	UserGuid   string    `json:"user_guid"`
+	SessionID  string    `json:"session_id"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	// Reason cause of failure or revocation
+	Reason string `json:"reason"`
+}
+
+// Filter conditions of audit events query, empty fields aren't used. From is inclusive, To is exclusive
+type Filter struct {
+	UserGuid string
+	Type     string
+	From     time.Time
+	To       time.Time
+	Limit    uint64
+	Offset   uint64
+}
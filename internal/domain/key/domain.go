@@ -0,0 +1,60 @@
+package key
+
+import "time"
+
+type Status string
+
+const (
+	// StatusActive key is used for signing new tokens and for verification
+	StatusActive Status = "active"
+	// StatusVerifyOnly key is used only for verification of already issued tokens
+	StatusVerifyOnly Status = "verify_only"
+	// StatusRetired key is not used at all
+	StatusRetired Status = "retired"
+)
+
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Secret    []byte
+	Status    Status
+	NotBefore time.Time
+	NotAfter  *time.Time
+}
+
+// InWindow check that moment t is inside validity window of key
+func (k SigningKey) InWindow(t time.Time) bool {
+	if t.Before(k.NotBefore) {
+		return false
+	}
+	if k.NotAfter != nil && !t.Before(*k.NotAfter) {
+		return false
+	}
+	return true
+}
+
+// CanSign check that key may be used for signing at moment t
+func (k SigningKey) CanSign(t time.Time) bool {
+	return k.Status == StatusActive && k.InWindow(t)
+}
+
+// CanVerify check that key may be used for verification at moment t
+func (k SigningKey) CanVerify(t time.Time) bool {
+	if k.Status != StatusActive && k.Status != StatusVerifyOnly {
+		return false
+	}
+	return k.NotAfter == nil || t.Before(*k.NotAfter)
+}
+
+// JWK public part of signing key as described in RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
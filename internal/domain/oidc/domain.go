@@ -0,0 +1,23 @@
+package oidc
+
+// ProviderMetadata OpenID Connect discovery document
+type ProviderMetadata struct {
+	Issuer                           string
+	AuthorizationEndpoint            string
+	TokenEndpoint                    string
+	UserinfoEndpoint                 string
+	JwksUri                          string
+	ScopesSupported                  []string
+	ResponseTypesSupported           []string
+	GrantTypesSupported              []string
+	SubjectTypesSupported            []string
+	IdTokenSigningAlgValuesSupported []string
+	ClaimsSupported                  []string
+}
+
+// IDTokenParams parameters of authentication request which are reflected in id token
+type IDTokenParams struct {
+	// Audience client id, configured audience is used when empty
+	Audience string
+	Nonce    string
+}
@@ -1,6 +1,23 @@
 package request
 
+import "context"
+
 type RequestData struct {
 	UserAgent string
 	IP        string
+	// ClientID client which requested tokens, optional
+	ClientID string
+}
+
+type dataKey struct{}
+
+// WithData return ctx carrying metadata of request, so services can record it
+func WithData(ctx context.Context, data RequestData) context.Context {
+	return context.WithValue(ctx, dataKey{}, data)
+}
+
+// FromContext return metadata of request carried by ctx
+func FromContext(ctx context.Context) (RequestData, bool) {
+	data, ok := ctx.Value(dataKey{}).(RequestData)
+	return data, ok
 }
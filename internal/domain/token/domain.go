@@ -1,18 +1,58 @@
 package token
 
-import "github.com/gbrlsnchs/jwt/v3"
+import (
+	"github.com/gbrlsnchs/jwt/v3"
+	"time"
+)
 
 type AccessTokenPayload struct {
 	jwt.Payload
-	Key string `json:"Key"`
+	Key       string `json:"Key"`
+	ClientID  string `json:"client_id,omitempty"`
+	SessionID string `json:"sid,omitempty"`
 }
 
 type TokensPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+	IDToken      string `json:"id_token,omitempty"`
+	// ExpiresIn lifetime of access token in seconds
+	ExpiresIn int `json:"expires_in,omitempty"`
+	// RefreshExpiresIn lifetime of refresh token in seconds
+	RefreshExpiresIn int `json:"refresh_expires_in,omitempty"`
+	// SessionID session of pair, it isn't sent to client
+	SessionID string `json:"-"`
 }
 
-type RefreshTokenPayload struct {
-	Time string
-	Key  string
+type IDTokenPayload struct {
+	jwt.Payload
+	AuthTime *jwt.Time `json:"auth_time,omitempty"`
+	Nonce    string    `json:"nonce,omitempty"`
+	AtHash   string    `json:"at_hash,omitempty"`
+}
+
+type Session struct {
+	ID              string
+	CreatedAt       time.Time
+	LastRefreshedAt time.Time
+	IP              string
+	UserAgent       string
+}
+
+// Introspection state of token as described in RFC 7662
+type Introspection struct {
+	Active bool
+	// TokenType Bearer for access token, it is empty for refresh token
+	TokenType string
+	Subject   string
+	ClientID  string
+	SessionID string
+	JWTID     string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+	IssuedAt  time.Time
+	//metadata of session
+	UserAgent string
+	IP        string
 }
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"net/netip"
+	"time"
+)
+
+const (
+	EventSessionCreated     = "session.created"
+	EventIPChanged          = "ip.changed"
+	EventTokenReuseDetected = "token.reuse_detected"
+	EventSessionRevoked     = "session.revoked"
+
+	// SchemaVersion version of event schema, it is increased on incompatible changes of envelope or data
+	SchemaVersion = 1
+)
+
+// EventTypes all types of events which can be subscribed to
+var EventTypes = []string{EventSessionCreated, EventIPChanged, EventTokenReuseDetected, EventSessionRevoked}
+
+// sharedAddressSpace carrier-grade NAT range (RFC 6598), it isn't reachable from internet like private ranges
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicAddr report whether receiver on addr is reachable from internet. Loopback, private, link-local
+// and unspecified addresses belong to internal network and webhooks aren't sent there
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.WithZone("").Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// reasons of session revocation
+const (
+	RevokeReasonLogout            = "logout"
+	RevokeReasonUserAgentMismatch = "user_agent_mismatch"
+	RevokeReasonReuseDetected     = "reuse_detected"
+	RevokeReasonEvicted           = "evicted"
+	RevokeReasonRevoked           = "revoked"
+)
+
+// Event envelope of webhook, data is one of types below according to type of event
+type Event struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	Version   int         `json:"version"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+type SessionCreated struct {
+	UserGuid  string `json:"user_guid"`
+	SessionID string `json:"session_id"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+}
+
+type IPChanged struct {
+	UserGuid   string `json:"user_guid"`
+	SessionID  string `json:"session_id"`
+	PreviousIP string `json:"previous_ip"`
+	IP         string `json:"ip"`
+	UserAgent  string `json:"user_agent"`
+}
+
+type TokenReuseDetected struct {
+	UserGuid  string `json:"user_guid"`
+	SessionID string `json:"session_id"`
+	// Generation number of replayed refresh token in session
+	Generation int    `json:"generation"`
+	IP         string `json:"ip"`
+	UserAgent  string `json:"user_agent"`
+}
+
+type SessionRevoked struct {
+	UserGuid  string `json:"user_guid"`
+	SessionID string `json:"session_id"`
+	Reason    string `json:"reason"`
+}
+
+// Subscription endpoint which receives events of chosen types signed by its secret
+type Subscription struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes []string
+	Active     bool
+	CreatedAt  time.Time
+}
+
+// Delivery webhook of one event to one subscription
+type Delivery struct {
+	ID             int64
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	// Payload json body of webhook
+	Payload       string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
+
+// DeliveryAttempt one request of delivery to endpoint of subscription
+type DeliveryAttempt struct {
+	ID             int64
+	DeliveryID     int64
+	URL            string
+	RequestHeaders map[string]string
+	// ResponseStatus status of response, 0 when response wasn't received
+	ResponseStatus int
+	Latency        time.Duration
+	Error          string
+	// Replay attempt was made manually by operator
+	Replay    bool
+	CreatedAt time.Time
+}
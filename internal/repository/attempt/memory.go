@@ -0,0 +1,8 @@
+package attempt
+
+import "medods/internal/repository"
+
+// NewMemoryRepository in-memory implementation of AttemptRepository
+func NewMemoryRepository() *repository.MemoryRepository[Attempt, FieldName] {
+	return repository.NewMemoryRepository[Attempt](IdField, CreatedAtField)
+}
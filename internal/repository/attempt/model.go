@@ -0,0 +1,18 @@
+package attempt
+
+import "time"
+
+// Attempt request of webhook delivery and its outcome
+type Attempt struct {
+	ID int64 `db:"id"`
+	// OutboxID id of delivered outbox event
+	OutboxID int64  `db:"outbox_id"`
+	URL      string `db:"url"`
+	// RequestHeaders json object with headers of request
+	RequestHeaders string    `db:"request_headers"`
+	ResponseStatus int       `db:"response_status"`
+	LatencyMs      int64     `db:"latency_ms"`
+	Error          string    `db:"error"`
+	Replay         bool      `db:"replay"`
+	CreatedAt      time.Time `db:"created_at"`
+}
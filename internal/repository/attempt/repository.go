@@ -0,0 +1,29 @@
+package attempt
+
+import (
+	"database/sql"
+	"medods/database"
+	"medods/internal/repository"
+)
+
+type FieldName string
+
+const (
+	IdField             FieldName = "id"
+	OutboxIdField       FieldName = "outbox_id"
+	URLField            FieldName = "url"
+	RequestHeadersField FieldName = "request_headers"
+	ResponseStatusField FieldName = "response_status"
+	LatencyMsField      FieldName = "latency_ms"
+	ErrorField          FieldName = "error"
+	ReplayField         FieldName = "replay"
+	CreatedAtField      FieldName = "created_at"
+)
+
+type AttemptRepository struct {
+	*repository.Repository[Attempt, FieldName]
+}
+
+func NewRepository(db *database.Database, txOpts *sql.TxOptions) *AttemptRepository {
+	return &AttemptRepository{Repository: repository.NewRepository[Attempt](db, txOpts, "webhook_attempts", IdField, CreatedAtField)}
+}
@@ -0,0 +1,8 @@
+package audit
+
+import "medods/internal/repository"
+
+// NewMemoryRepository in-memory implementation of AuditRepository
+func NewMemoryRepository() *repository.MemoryRepository[Event, FieldName] {
+	return repository.NewMemoryRepository[Event](IdField)
+}
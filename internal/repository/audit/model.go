@@ -0,0 +1,16 @@
+package audit
+
+import "time"
+
+// Event row of append-only audit log
+type Event struct {
+	ID         int64     `db:"id"`
+	OccurredAt time.Time `db:"occurred_at"`
+	Type       string    `db:"type"`
+	Outcome    string    `db:"outcome"`
+	UserGuid   string    `db:"user_guid"`
+	SessionID  string    `db:"session_id"`
+	IP         string    `db:"ip"`
+	UserAgent  string    `db:"user_agent"`
+	Reason     string    `db:"reason"`
+}
@@ -0,0 +1,30 @@
+package audit
+
+import (
+	"database/sql"
+	"medods/database"
+	"medods/internal/repository"
+)
+
+type FieldName string
+
+const (
+	IdField         FieldName = "id"
+	OccurredAtField FieldName = "occurred_at"
+	TypeField       FieldName = "type"
+	OutcomeField    FieldName = "outcome"
+	UserGuidField   FieldName = "user_guid"
+	SessionIdField  FieldName = "session_id"
+	IPField         FieldName = "ip"
+	UserAgentField  FieldName = "user_agent"
+	ReasonField     FieldName = "reason"
+)
+
+// AuditRepository storage of audit log, rows are never updated or deleted
+type AuditRepository struct {
+	*repository.Repository[Event, FieldName]
+}
+
+func NewRepository(db *database.Database, txOpts *sql.TxOptions) *AuditRepository {
+	return &AuditRepository{Repository: repository.NewRepository[Event](db, txOpts, "audit_events", IdField)}
+}
@@ -0,0 +1,87 @@
+package blacklist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lib/pq"
+	"log"
+	"medods/database"
+	"time"
+)
+
+// notifyChannel channel of notifications about created blacklist entries
+const notifyChannel = "blacklist_created"
+
+const (
+	listenerMinReconnect = time.Second
+	listenerMaxReconnect = time.Minute
+	listenerPingInterval = 90 * time.Second
+)
+
+// Listener receive entries created by all replicas via LISTEN/NOTIFY
+type Listener struct {
+	dsn string
+}
+
+func NewListener(conf *database.DBConfig) *Listener {
+	return &Listener{dsn: conf.DSN()}
+}
+
+// Listen start listening and return channel of created entries. Nil is sent when connection is lost
+// and after reconnect, notifications sent meanwhile are missed. Channel is closed when ctx is done
+func (l *Listener) Listen(ctx context.Context) (<-chan *Blacklist, error) {
+	lost := make(chan struct{}, 1)
+	pl := pq.NewListener(l.dsn, listenerMinReconnect, listenerMaxReconnect, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("blacklist listener error: %s", err)
+		}
+		if ev == pq.ListenerEventDisconnected {
+			select {
+			case lost <- struct{}{}:
+			default:
+			}
+		}
+	})
+	if err := pl.Listen(notifyChannel); err != nil {
+		_ = pl.Close()
+		return nil, fmt.Errorf("listen %s: %w", notifyChannel, err)
+	}
+
+	entries := make(chan *Blacklist)
+	go func() {
+		defer close(entries)
+		defer pl.Close()
+
+		ticker := time.NewTicker(listenerPingInterval)
+		defer ticker.Stop()
+		for {
+			var entry *Blacklist
+			select {
+			case <-ctx.Done():
+				return
+			case <-lost:
+			case <-ticker.C:
+				//detect broken connection, listener reconnects then
+				go func() { _ = pl.Ping() }()
+				continue
+			case n := <-pl.Notify:
+				if n != nil {
+					entry = &Blacklist{}
+					//undecodable entry is reported as lost notification
+					if err := json.Unmarshal([]byte(n.Extra), entry); err != nil {
+						log.Printf("decode blacklist notification error: %s", err)
+						entry = nil
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case entries <- entry:
+			}
+		}
+	}()
+	return entries, nil
+}
@@ -0,0 +1,8 @@
+package blacklist
+
+import "medods/internal/repository"
+
+// NewMemoryRepository in-memory implementation of BlacklistRepository
+func NewMemoryRepository() *repository.MemoryRepository[Blacklist, FieldName] {
+	return repository.NewMemoryRepository[Blacklist, FieldName]()
+}
@@ -1,6 +1,14 @@
 package blacklist
 
+import "time"
+
 //go:generate go tool eos generator repository --type Blacklist --default_id=false
 type Blacklist struct {
+	// AccessToken whole blocked token, only tokens issued without unique jti are blocked by it
 	AccessToken string `db:"access_token"`
+	// TokenKey blocks all access tokens with this key, used when token itself is unknown
+	TokenKey string `db:"token_key"`
+	JTI      string `db:"jti"`
+	// ExpiresAt moment when blocked tokens expire, entry isn't needed after it
+	ExpiresAt time.Time `db:"expires_at"`
 }
@@ -0,0 +1,379 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryRepository thread-safe in-memory table with the same semantics as Repository,
+// it is used in tests and hermetic mode of server
+type MemoryRepository[T any, F ~string] struct {
+	mu        sync.RWMutex
+	rows      []T
+	seq       int64
+	columns   map[string]int
+	generated map[string]bool
+}
+
+// NewMemoryRepository create in-memory table. Generated columns are filled on insert:
+// integer columns by sequence, time columns by current time
+func NewMemoryRepository[T any, F ~string](generated ...F) *MemoryRepository[T, F] {
+	r := &MemoryRepository[T, F]{columns: make(map[string]int), generated: make(map[string]bool, len(generated))}
+	for _, v := range generated {
+		r.generated[string(v)] = true
+	}
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("db"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		r.columns[name] = i
+	}
+	return r
+}
+
+// field return value of column of row, only columns of model are allowed
+func (r *MemoryRepository[T, F]) field(row *T, field F) (reflect.Value, error) {
+	i, ok := r.columns[string(field)]
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("unknown column %q", field)
+	}
+	return reflect.ValueOf(row).Elem().Field(i), nil
+}
+
+func (r *MemoryRepository[T, F]) Create(ctx context.Context, model *T) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seq++
+	v := reflect.ValueOf(model).Elem()
+	for name := range r.generated {
+		f := v.Field(r.columns[name])
+		switch {
+		case f.Type() == reflect.TypeOf(time.Time{}):
+			f.Set(reflect.ValueOf(time.Now()))
+		case f.CanInt():
+			f.SetInt(r.seq)
+		}
+	}
+	r.rows = append(r.rows, *model)
+	return nil
+}
+
+// GetOne return first row matched by query, sql.ErrNoRows is returned when nothing is found
+func (r *MemoryRepository[T, F]) GetOne(ctx context.Context, q Query[F]) (*T, error) {
+	q.Limit = 1
+	res, err := r.GetMany(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &res[0], nil
+}
+
+func (r *MemoryRepository[T, F]) GetMany(ctx context.Context, q Query[F]) ([]T, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]T, 0)
+	for i := range r.rows {
+		ok, err := r.match(&r.rows[i], q.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, r.rows[i])
+		}
+	}
+
+	var sortErr error
+	sort.SliceStable(res, func(i, j int) bool {
+		for _, o := range q.Orders {
+			a, err := r.field(&res[i], o.Field)
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			b, _ := r.field(&res[j], o.Field)
+			c, ok := compare(a.Interface(), b.Interface())
+			if !ok || c == 0 {
+				continue
+			}
+			return (c < 0) != o.Desc
+		}
+		return false
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	if q.Offset > 0 {
+		res = res[min(q.Offset, uint64(len(res))):]
+	}
+	if q.Limit > 0 {
+		res = res[:min(q.Limit, uint64(len(res)))]
+	}
+	return res, nil
+}
+
+func (r *MemoryRepository[T, F]) Update(ctx context.Context, updated *T, filters ...Filter[F]) (int64, error) {
+	v := reflect.ValueOf(updated).Elem()
+	set := make(map[F]interface{}, len(r.columns))
+	for name, i := range r.columns {
+		if !r.generated[name] {
+			set[F(name)] = v.Field(i).Interface()
+		}
+	}
+	return r.UpdateFields(ctx, set, filters...)
+}
+
+func (r *MemoryRepository[T, F]) UpdateFields(ctx context.Context, set map[F]interface{}, filters ...Filter[F]) (int64, error) {
+	if len(set) == 0 {
+		return 0, errors.New("nothing to update")
+	}
+	if len(filters) == 0 {
+		return 0, errors.New("update without conditions")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var n int64
+	for i := range r.rows {
+		ok, err := r.match(&r.rows[i], filters)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			continue
+		}
+		for k, v := range set {
+			f, err := r.field(&r.rows[i], k)
+			if err != nil {
+				return 0, err
+			}
+			if v == nil {
+				f.Set(reflect.Zero(f.Type()))
+				continue
+			}
+			val := reflect.ValueOf(v)
+			if !val.Type().ConvertibleTo(f.Type()) {
+				return 0, fmt.Errorf("can't set %T to column %q", v, k)
+			}
+			f.Set(val.Convert(f.Type()))
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (r *MemoryRepository[T, F]) Delete(ctx context.Context, filters ...Filter[F]) (int64, error) {
+	if len(filters) == 0 {
+		return 0, errors.New("delete without conditions")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := make([]T, 0, len(r.rows))
+	for i := range r.rows {
+		ok, err := r.match(&r.rows[i], filters)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			kept = append(kept, r.rows[i])
+		}
+	}
+	n := int64(len(r.rows) - len(kept))
+	r.rows = kept
+	return n, nil
+}
+
+func (r *MemoryRepository[T, F]) match(row *T, filters []Filter[F]) (bool, error) {
+	get := func(field F) (interface{}, error) {
+		f, err := r.field(row, field)
+		if err != nil {
+			return nil, err
+		}
+		return f.Interface(), nil
+	}
+	for _, v := range filters {
+		ok, err := v.match(get)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// match evaluate filter like database does, comparison with null never matches
+func (f Filter[F]) match(get func(F) (interface{}, error)) (bool, error) {
+	switch f.op {
+	case opAnd, opOr:
+		for _, v := range f.nested {
+			ok, err := v.match(get)
+			if err != nil {
+				return false, err
+			}
+			if ok == (f.op == opOr) {
+				return ok, nil
+			}
+		}
+		return f.op == opAnd, nil
+	}
+
+	value, err := get(f.field)
+	if err != nil {
+		return false, err
+	}
+	value = normalize(value)
+
+	switch f.op {
+	case opIsNull:
+		return value == nil, nil
+	case opNotNull:
+		return value != nil, nil
+	case opEq, opIn:
+		//slice value means IN as in squirrel
+		if values := reflect.ValueOf(f.value); f.value != nil && values.Kind() == reflect.Slice && values.Type().Elem().Kind() != reflect.Uint8 {
+			for i := 0; i < values.Len(); i++ {
+				if c, ok := compare(value, values.Index(i).Interface()); ok && c == 0 {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		if f.value == nil {
+			return value == nil, nil
+		}
+	}
+
+	c, ok := compare(value, f.value)
+	if !ok {
+		return false, nil
+	}
+	switch f.op {
+	case opEq:
+		return c == 0, nil
+	case opNotEq:
+		return c != 0, nil
+	case opLt:
+		return c < 0, nil
+	case opLtOrEq:
+		return c <= 0, nil
+	case opGt:
+		return c > 0, nil
+	case opGtOrEq:
+		return c >= 0, nil
+	}
+	return false, fmt.Errorf("unknown operator %d", f.op)
+}
+
+// normalize convert value to one of nil, int64, float64, bool, string or time.Time
+func normalize(v interface{}) interface{} {
+	if valuer, ok := v.(driver.Valuer); ok {
+		dv, err := valuer.Value()
+		if err != nil {
+			return nil
+		}
+		v = dv
+	}
+	switch val := v.(type) {
+	case nil, bool, string, time.Time:
+		return val
+	case []byte:
+		return string(val)
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return rv.Int()
+	case rv.CanUint():
+		return int64(rv.Uint())
+	case rv.CanFloat():
+		return rv.Float()
+	case rv.Kind() == reflect.String:
+		return rv.String()
+	case rv.Kind() == reflect.Bool:
+		return rv.Bool()
+	}
+	return v
+}
+
+// compare return -1, 0 or 1, false is returned when values aren't comparable or one of them is null
+func compare(a interface{}, b interface{}) (int, bool) {
+	a, b = normalize(a), normalize(b)
+	if a == nil || b == nil {
+		return 0, false
+	}
+	switch av := a.(type) {
+	case int64:
+		switch bv := b.(type) {
+		case int64:
+			return cmp.Compare(av, bv), true
+		case float64:
+			return cmp.Compare(float64(av), bv), true
+		}
+	case float64:
+		switch bv := b.(type) {
+		case int64:
+			return cmp.Compare(av, float64(bv)), true
+		case float64:
+			return cmp.Compare(av, bv), true
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			return strings.Compare(av, bv), true
+		}
+	case bool:
+		if bv, ok := b.(bool); ok {
+			switch {
+			case av == bv:
+				return 0, true
+			case !av:
+				return -1, true
+			}
+			return 1, true
+		}
+	case time.Time:
+		if bv, ok := b.(time.Time); ok {
+			return av.Compare(bv), true
+		}
+	}
+	return 0, false
+}
+
+// MemoryUnitOfWork unit of work for in-memory repositories. Changes are applied immediately
+// and aren't rolled back on error
+type MemoryUnitOfWork struct{}
+
+func NewMemoryUnitOfWork() *MemoryUnitOfWork {
+	return &MemoryUnitOfWork{}
+}
+
+// Do run fn, functions passed to AfterCommit run when the outermost call succeeds
+func (u *MemoryUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		return fn(ctx)
+	}
+	hooks := &afterCommit{}
+	if err := fn(context.WithValue(ctx, afterCommitKey{}, hooks)); err != nil {
+		return err
+	}
+	hooks.run()
+	return nil
+}
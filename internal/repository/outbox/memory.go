@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"context"
+	"medods/internal/repository"
+	"sync"
+	"time"
+)
+
+// MemoryRepository in-memory implementation of OutboxRepository
+type MemoryRepository struct {
+	*repository.MemoryRepository[Event, FieldName]
+	// mu serialize claims, as row locks of database do
+	mu sync.Mutex
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{MemoryRepository: repository.NewMemoryRepository[Event](IdField, CreatedAtField)}
+}
+
+// ClaimDue take up to limit pending events which are due and postpone them by lease
+func (r *MemoryRepository) ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	events, err := r.GetMany(ctx, repository.Where(
+		repository.Eq(StatusField, StatusPending),
+		repository.LtOrEq(NextAttemptAtField, now)).
+		OrderBy(NextAttemptAtField, false).
+		OrderBy(IdField, false).
+		Page(limit, 0))
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		events[i].NextAttemptAt = now.Add(lease)
+		_, err = r.UpdateFields(ctx, map[FieldName]interface{}{NextAttemptAtField: events[i].NextAttemptAt},
+			repository.Eq(IdField, events[i].ID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	// StatusDead event isn't delivered after all attempts and isn't retried anymore
+	StatusDead = "dead"
+)
+
+// Event webhook for one subscription waiting for delivery or already processed
+type Event struct {
+	ID             int64  `db:"id"`
+	SubscriptionID string `db:"subscription_id"`
+	// EventID id of event, it is the same in webhooks of all subscriptions
+	EventID   string `db:"event_id"`
+	EventType string `db:"event_type"`
+	// Payload json object sent as body of webhook
+	Payload       string       `db:"payload"`
+	Status        string       `db:"status"`
+	Attempts      int          `db:"attempts"`
+	NextAttemptAt time.Time    `db:"next_attempt_at"`
+	LastError     string       `db:"last_error"`
+	CreatedAt     time.Time    `db:"created_at"`
+	DeliveredAt   sql.NullTime `db:"delivered_at"`
+}
@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"medods/database"
+	"medods/internal/repository"
+	"sort"
+	"strings"
+	"time"
+)
+
+type FieldName string
+
+const (
+	IdField             FieldName = "id"
+	SubscriptionIdField FieldName = "subscription_id"
+	EventIdField        FieldName = "event_id"
+	EventTypeField      FieldName = "event_type"
+	PayloadField        FieldName = "payload"
+	StatusField         FieldName = "status"
+	AttemptsField       FieldName = "attempts"
+	NextAttemptAtField  FieldName = "next_attempt_at"
+	LastErrorField      FieldName = "last_error"
+	CreatedAtField      FieldName = "created_at"
+	DeliveredAtField    FieldName = "delivered_at"
+)
+
+type OutboxRepository struct {
+	*repository.Repository[Event, FieldName]
+}
+
+func NewRepository(db *database.Database, txOpts *sql.TxOptions) *OutboxRepository {
+	return &OutboxRepository{Repository: repository.NewRepository[Event](db, txOpts, "outbox", IdField, CreatedAtField)}
+}
+
+// ClaimDue take up to limit pending events which are due and postpone them by lease,
+// so concurrent dispatchers of other replicas skip them while they are delivered
+func (r *OutboxRepository) ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]Event, error) {
+	now := time.Now()
+	due := repository.Builder.Select("id").
+		From("outbox").
+		Where(sq.Eq{"status": StatusPending}).
+		Where(sq.LtOrEq{"next_attempt_at": now}).
+		OrderBy("next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	q, args, err := repository.Builder.Update("outbox").
+		Set("next_attempt_at", now.Add(lease)).
+		Where(due.Prefix("id IN (").Suffix(")")).
+		Suffix("RETURNING " + strings.Join(r.Columns(), ", ")).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build claim of outbox events: %w", err)
+	}
+
+	events := make([]Event, 0)
+	err = r.Tx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, q, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		return sqlx.StructScan(rows, &events)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("claim outbox events: %w", err)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+	return events, nil
+}
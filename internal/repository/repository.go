@@ -0,0 +1,423 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"medods/database"
+	"reflect"
+	"strings"
+)
+
+// Builder builder of queries with postgres placeholders
+var Builder = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+type operator int
+
+const (
+	opEq operator = iota
+	opNotEq
+	opLt
+	opLtOrEq
+	opGt
+	opGtOrEq
+	opIn
+	opIsNull
+	opNotNull
+	opAnd
+	opOr
+)
+
+// Filter condition of query on column of table or group of conditions
+type Filter[F ~string] struct {
+	op     operator
+	field  F
+	value  interface{}
+	nested []Filter[F]
+}
+
+// Eq column is equal to value
+func Eq[F ~string](field F, value interface{}) Filter[F] {
+	return Filter[F]{op: opEq, field: field, value: value}
+}
+
+// NotEq column is not equal to value
+func NotEq[F ~string](field F, value interface{}) Filter[F] {
+	return Filter[F]{op: opNotEq, field: field, value: value}
+}
+
+// Lt column is less than value
+func Lt[F ~string](field F, value interface{}) Filter[F] {
+	return Filter[F]{op: opLt, field: field, value: value}
+}
+
+// LtOrEq column is less than or equal to value
+func LtOrEq[F ~string](field F, value interface{}) Filter[F] {
+	return Filter[F]{op: opLtOrEq, field: field, value: value}
+}
+
+// Gt column is greater than value
+func Gt[F ~string](field F, value interface{}) Filter[F] {
+	return Filter[F]{op: opGt, field: field, value: value}
+}
+
+// GtOrEq column is greater than or equal to value
+func GtOrEq[F ~string](field F, value interface{}) Filter[F] {
+	return Filter[F]{op: opGtOrEq, field: field, value: value}
+}
+
+// In column is equal to one of values, nothing matches empty values
+func In[F ~string, V any](field F, values ...V) Filter[F] {
+	return Filter[F]{op: opIn, field: field, value: values}
+}
+
+// IsNull column is null
+func IsNull[F ~string](field F) Filter[F] {
+	return Filter[F]{op: opIsNull, field: field}
+}
+
+// NotNull column is not null
+func NotNull[F ~string](field F) Filter[F] {
+	return Filter[F]{op: opNotNull, field: field}
+}
+
+// And all of filters match
+func And[F ~string](filters ...Filter[F]) Filter[F] {
+	return Filter[F]{op: opAnd, nested: filters}
+}
+
+// Or at least one of filters matches
+func Or[F ~string](filters ...Filter[F]) Filter[F] {
+	return Filter[F]{op: opOr, nested: filters}
+}
+
+// sqlizer convert filter to squirrel expression, column is checked by column func
+func (f Filter[F]) sqlizer(column func(F) (string, error)) (sq.Sqlizer, error) {
+	if f.op == opAnd || f.op == opOr {
+		nested := make([]sq.Sqlizer, len(f.nested))
+		for i, v := range f.nested {
+			s, err := v.sqlizer(column)
+			if err != nil {
+				return nil, err
+			}
+			nested[i] = s
+		}
+		if f.op == opOr {
+			//empty or group matches nothing
+			if len(nested) == 0 {
+				return sq.Expr("1=0"), nil
+			}
+			return sq.Or(nested), nil
+		}
+		return sq.And(nested), nil
+	}
+
+	c, err := column(f.field)
+	if err != nil {
+		return nil, err
+	}
+	switch f.op {
+	case opEq, opIn:
+		return sq.Eq{c: f.value}, nil
+	case opNotEq:
+		return sq.NotEq{c: f.value}, nil
+	case opLt:
+		return sq.Lt{c: f.value}, nil
+	case opLtOrEq:
+		return sq.LtOrEq{c: f.value}, nil
+	case opGt:
+		return sq.Gt{c: f.value}, nil
+	case opGtOrEq:
+		return sq.GtOrEq{c: f.value}, nil
+	case opIsNull:
+		return sq.Eq{c: nil}, nil
+	case opNotNull:
+		return sq.NotEq{c: nil}, nil
+	}
+	return nil, fmt.Errorf("unknown operator %d", f.op)
+}
+
+type Order[F ~string] struct {
+	Field F
+	Desc  bool
+}
+
+// Query conditions, ordering and pagination of select, zero limit means no limit
+type Query[F ~string] struct {
+	Filters []Filter[F]
+	Orders  []Order[F]
+	Limit   uint64
+	Offset  uint64
+}
+
+func Where[F ~string](filters ...Filter[F]) Query[F] {
+	return Query[F]{Filters: filters}
+}
+
+func (q Query[F]) OrderBy(field F, desc bool) Query[F] {
+	q.Orders = append(q.Orders, Order[F]{Field: field, Desc: desc})
+	return q
+}
+
+func (q Query[F]) Page(limit uint64, offset uint64) Query[F] {
+	q.Limit, q.Offset = limit, offset
+	return q
+}
+
+type column struct {
+	name  string
+	index int
+}
+
+// Repository typed access to one table. Rows are scanned to T by db tags of its fields,
+// so order of columns in table doesn't matter
+type Repository[T any, F ~string] struct {
+	db        *database.Database
+	txOpts    *sql.TxOptions
+	table     string
+	columns   []column
+	known     map[string]bool
+	generated map[string]bool
+}
+
+// NewRepository create repository of table. Generated columns are filled by database on insert
+// and returned back to model
+func NewRepository[T any, F ~string](db *database.Database, txOpts *sql.TxOptions, table string, generated ...F) *Repository[T, F] {
+	r := &Repository[T, F]{db: db, txOpts: txOpts, table: table, known: make(map[string]bool), generated: make(map[string]bool, len(generated))}
+	for _, v := range generated {
+		r.generated[string(v)] = true
+	}
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("db"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		r.columns = append(r.columns, column{name: name, index: i})
+		r.known[name] = true
+	}
+	return r
+}
+
+// Tx run fn in transaction, ctx passed to fn carries it, so calls of repositories with it join the transaction
+func (r *Repository[T, F]) Tx(ctx context.Context, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	return TxRunner(ctx, r.db, r.txOpts, func(tx *sql.Tx) error {
+		return fn(WithTx(ctx, tx), tx)
+	})
+}
+
+// column return name of column, only columns of model are allowed in queries
+func (r *Repository[T, F]) column(field F) (string, error) {
+	if !r.known[string(field)] {
+		return "", fmt.Errorf("unknown column %q of %s", field, r.table)
+	}
+	return string(field), nil
+}
+
+// Columns return columns of table in order of fields of model
+func (r *Repository[T, F]) Columns() []string {
+	names := make([]string, len(r.columns))
+	for i, v := range r.columns {
+		names[i] = v.name
+	}
+	return names
+}
+
+// where convert filters to conditions joined by AND
+func (r *Repository[T, F]) where(filters []Filter[F]) (sq.And, error) {
+	conds := make(sq.And, len(filters))
+	for i, v := range filters {
+		s, err := v.sqlizer(r.column)
+		if err != nil {
+			return nil, err
+		}
+		conds[i] = s
+	}
+	return conds, nil
+}
+
+func (r *Repository[T, F]) selectQuery(q Query[F]) (string, []interface{}, error) {
+	conds, err := r.where(q.Filters)
+	if err != nil {
+		return "", nil, err
+	}
+
+	b := Builder.Select(r.Columns()...).From(r.table)
+	if len(conds) > 0 {
+		b = b.Where(conds)
+	}
+	for _, v := range q.Orders {
+		c, err := r.column(v.Field)
+		if err != nil {
+			return "", nil, err
+		}
+		if v.Desc {
+			c += " DESC"
+		}
+		b = b.OrderBy(c)
+	}
+	if q.Limit > 0 {
+		b = b.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		b = b.Offset(q.Offset)
+	}
+	return b.ToSql()
+}
+
+// Create insert model, generated columns are written back to it
+func (r *Repository[T, F]) Create(ctx context.Context, model *T) error {
+	v := reflect.ValueOf(model).Elem()
+
+	names := make([]string, 0, len(r.columns))
+	values := make([]interface{}, 0, len(r.columns))
+	returning := make([]string, 0, len(r.generated))
+	dest := make([]interface{}, 0, len(r.generated))
+	for _, c := range r.columns {
+		if r.generated[c.name] {
+			returning = append(returning, c.name)
+			dest = append(dest, v.Field(c.index).Addr().Interface())
+			continue
+		}
+		names = append(names, c.name)
+		values = append(values, v.Field(c.index).Interface())
+	}
+
+	b := Builder.Insert(r.table).Columns(names...).Values(values...)
+	if len(returning) > 0 {
+		b = b.Suffix("RETURNING " + strings.Join(returning, ", "))
+	}
+	q, args, err := b.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert to %s: %w", r.table, err)
+	}
+
+	return TxRunner(ctx, r.db, r.txOpts, func(tx *sql.Tx) error {
+		if len(returning) == 0 {
+			_, err := tx.ExecContext(ctx, q, args...)
+			return err
+		}
+		return tx.QueryRowContext(ctx, q, args...).Scan(dest...)
+	})
+}
+
+// GetOne return first row matched by query, sql.ErrNoRows is returned when nothing is found
+func (r *Repository[T, F]) GetOne(ctx context.Context, q Query[F]) (*T, error) {
+	q.Limit = 1
+	res, err := r.GetMany(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &res[0], nil
+}
+
+// GetMany return all rows matched by query
+func (r *Repository[T, F]) GetMany(ctx context.Context, q Query[F]) ([]T, error) {
+	query, values, err := r.selectQuery(q)
+	if err != nil {
+		return nil, fmt.Errorf("build select from %s: %w", r.table, err)
+	}
+
+	res := make([]T, 0)
+	err = TxRunner(ctx, r.db, r.txOpts, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, values...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		return sqlx.StructScan(rows, &res)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("select from %s: %w", r.table, err)
+	}
+	return res, nil
+}
+
+// Update write all not generated columns of model to rows matched by filters and return number of updated rows
+func (r *Repository[T, F]) Update(ctx context.Context, updated *T, filters ...Filter[F]) (int64, error) {
+	v := reflect.ValueOf(updated).Elem()
+	set := make(map[F]interface{}, len(r.columns))
+	for _, c := range r.columns {
+		if !r.generated[c.name] {
+			set[F(c.name)] = v.Field(c.index).Interface()
+		}
+	}
+	return r.UpdateFields(ctx, set, filters...)
+}
+
+// UpdateFields set values of columns of rows matched by filters and return number of updated rows
+func (r *Repository[T, F]) UpdateFields(ctx context.Context, set map[F]interface{}, filters ...Filter[F]) (int64, error) {
+	if len(set) == 0 {
+		return 0, errors.New("nothing to update")
+	}
+	if len(filters) == 0 {
+		return 0, errors.New("update without conditions")
+	}
+
+	values := make(map[string]interface{}, len(set))
+	for k, v := range set {
+		c, err := r.column(k)
+		if err != nil {
+			return 0, err
+		}
+		values[c] = v
+	}
+	conds, err := r.where(filters)
+	if err != nil {
+		return 0, err
+	}
+	q, args, err := Builder.Update(r.table).SetMap(values).Where(conds).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build update of %s: %w", r.table, err)
+	}
+
+	var n int64
+	err = TxRunner(ctx, r.db, r.txOpts, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, q, args...)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("update %s: %w", r.table, err)
+	}
+	return n, nil
+}
+
+// Delete remove rows matched by filters and return number of removed rows
+func (r *Repository[T, F]) Delete(ctx context.Context, filters ...Filter[F]) (int64, error) {
+	if len(filters) == 0 {
+		return 0, errors.New("delete without conditions")
+	}
+	conds, err := r.where(filters)
+	if err != nil {
+		return 0, err
+	}
+	q, args, err := Builder.Delete(r.table).Where(conds).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build delete from %s: %w", r.table, err)
+	}
+
+	var n int64
+	err = TxRunner(ctx, r.db, r.txOpts, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, q, args...)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete from %s: %w", r.table, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testField string
+
+const (
+	testIdField        testField = "id"
+	testNameField      testField = "name"
+	testCreatedAtField testField = "created_at"
+	testUnknownField   testField = "password"
+)
+
+type testModel struct {
+	ID        string    `db:"id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+	Ignored   string    `db:"-"`
+	Untagged  string
+}
+
+func TestSelectQuery(t *testing.T) {
+	r := NewRepository[testModel, testField](nil, nil, "items")
+	since := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query Query[testField]
+		sql   string
+		args  []interface{}
+		err   string
+	}{
+		{
+			name:  "no filters",
+			query: Query[testField]{},
+			sql:   "SELECT id, name, created_at FROM items",
+		},
+		{
+			name:  "eq",
+			query: Where(Eq(testIdField, "1")),
+			sql:   "SELECT id, name, created_at FROM items WHERE (id = $1)",
+			args:  []interface{}{"1"},
+		},
+		{
+			name:  "not eq",
+			query: Where(NotEq(testNameField, "a")),
+			sql:   "SELECT id, name, created_at FROM items WHERE (name <> $1)",
+			args:  []interface{}{"a"},
+		},
+		{
+			name:  "comparisons",
+			query: Where(Lt(testCreatedAtField, since), LtOrEq(testCreatedAtField, since), Gt(testCreatedAtField, since), GtOrEq(testCreatedAtField, since)),
+			sql:   "SELECT id, name, created_at FROM items WHERE (created_at < $1 AND created_at <= $2 AND created_at > $3 AND created_at >= $4)",
+			args:  []interface{}{since, since, since, since},
+		},
+		{
+			name:  "in",
+			query: Where(In(testIdField, "1", "2")),
+			sql:   "SELECT id, name, created_at FROM items WHERE (id IN ($1,$2))",
+			args:  []interface{}{"1", "2"},
+		},
+		{
+			name:  "empty in matches nothing",
+			query: Where(In[testField, string](testIdField)),
+			sql:   "SELECT id, name, created_at FROM items WHERE ((1=0))",
+		},
+		{
+			name:  "null checks",
+			query: Where(IsNull(testNameField), NotNull(testCreatedAtField)),
+			sql:   "SELECT id, name, created_at FROM items WHERE (name IS NULL AND created_at IS NOT NULL)",
+		},
+		{
+			name:  "nested groups",
+			query: Where(Or(Eq(testIdField, "1"), And(Eq(testNameField, "a"), Gt(testCreatedAtField, since)))),
+			sql:   "SELECT id, name, created_at FROM items WHERE ((id = $1 OR (name = $2 AND created_at > $3)))",
+			args:  []interface{}{"1", "a", since},
+		},
+		{
+			name:  "empty or matches nothing",
+			query: Where(Or[testField]()),
+			sql:   "SELECT id, name, created_at FROM items WHERE (1=0)",
+		},
+		{
+			name:  "order and page",
+			query: Where(Eq(testNameField, "a")).OrderBy(testCreatedAtField, true).OrderBy(testIdField, false).Page(10, 20),
+			sql:   "SELECT id, name, created_at FROM items WHERE (name = $1) ORDER BY created_at DESC, id LIMIT 10 OFFSET 20",
+			args:  []interface{}{"a"},
+		},
+		{
+			name:  "unknown column",
+			query: Where(Eq(testUnknownField, "x")),
+			err:   `unknown column "password" of items`,
+		},
+		{
+			name:  "unknown column in group",
+			query: Where(Or(Eq(testIdField, "1"), Eq(testUnknownField, "x"))),
+			err:   `unknown column "password" of items`,
+		},
+		{
+			name:  "unknown order column",
+			query: Query[testField]{}.OrderBy(testUnknownField, false),
+			err:   `unknown column "password" of items`,
+		},
+		{
+			name:  "untagged field isn't column",
+			query: Where(Eq(testField("Untagged"), "x")),
+			err:   `unknown column "Untagged" of items`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := r.selectQuery(tt.query)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sql != tt.sql {
+				t.Fatalf("sql\n%s\nwant\n%s", sql, tt.sql)
+			}
+			if len(args) != 0 || len(tt.args) != 0 {
+				if !reflect.DeepEqual(args, tt.args) {
+					t.Fatalf("args %v, want %v", args, tt.args)
+				}
+			}
+		})
+	}
+}
+
+func TestChangesRejectUnknownColumns(t *testing.T) {
+	r := NewRepository[testModel, testField](nil, nil, "items")
+	ctx := context.Background()
+
+	if _, err := r.UpdateFields(ctx, map[testField]interface{}{testUnknownField: "x"}, Eq(testIdField, "1")); err == nil {
+		t.Fatal("update of unknown column is built")
+	}
+	if _, err := r.UpdateFields(ctx, map[testField]interface{}{testNameField: "x"}, Eq(testUnknownField, "1")); err == nil {
+		t.Fatal("update filtered by unknown column is built")
+	}
+	if _, err := r.UpdateFields(ctx, map[testField]interface{}{testNameField: "x"}); err == nil {
+		t.Fatal("update without conditions is built")
+	}
+	if _, err := r.Delete(ctx, Eq(testUnknownField, "1")); err == nil {
+		t.Fatal("delete filtered by unknown column is built")
+	}
+	if _, err := r.Delete(ctx); err == nil {
+		t.Fatal("delete without conditions is built")
+	}
+}
+
+func TestAfterCommit(t *testing.T) {
+	uow := NewMemoryUnitOfWork()
+	ctx := context.Background()
+
+	var committed []string
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		//nested unit of work joins outer one, its functions wait for the outermost commit
+		err := uow.Do(ctx, func(ctx context.Context) error {
+			AfterCommit(ctx, func() { committed = append(committed, "nested") })
+			return nil
+		})
+		if len(committed) != 0 {
+			t.Fatal("function is run before commit")
+		}
+		AfterCommit(ctx, func() { committed = append(committed, "outer") })
+		return err
+	})
+	if err != nil || !reflect.DeepEqual(committed, []string{"nested", "outer"}) {
+		t.Fatalf("committed %v, error %v", committed, err)
+	}
+
+	committed = nil
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		AfterCommit(ctx, func() { committed = append(committed, "rolled back") })
+		return errors.New("failed")
+	})
+	if err == nil || len(committed) != 0 {
+		t.Fatalf("committed %v after rollback, error %v", committed, err)
+	}
+
+	AfterCommit(ctx, func() { committed = append(committed, "immediate") })
+	if !reflect.DeepEqual(committed, []string{"immediate"}) {
+		t.Fatalf("function without unit of work isn't run: %v", committed)
+	}
+}
@@ -0,0 +1,25 @@
+package signingkey
+
+import (
+	"context"
+	"medods/internal/repository"
+)
+
+// MemoryRepository in-memory implementation of SigningKeyRepository
+type MemoryRepository struct {
+	*repository.MemoryRepository[SigningKey, FieldName]
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{MemoryRepository: repository.NewMemoryRepository[SigningKey, FieldName]()}
+}
+
+// GetAll return all keys ordered by start of validity
+func (r *MemoryRepository) GetAll(ctx context.Context) ([]SigningKey, error) {
+	return r.GetMany(ctx, repository.Query[FieldName]{}.OrderBy(NotBeforeField, false))
+}
+
+// Lock do nothing, in-memory keys aren't shared between replicas
+func (r *MemoryRepository) Lock(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,16 @@
+package signingkey
+
+import (
+	"database/sql"
+	"time"
+)
+
+//go:generate go tool eos generator repository --type SigningKey --default_id=false
+type SigningKey struct {
+	ID        string       `db:"id" eos:"id"`
+	Algorithm string       `db:"algorithm"`
+	Secret    string       `db:"secret"`
+	Status    string       `db:"status"`
+	NotBefore time.Time    `db:"not_before"`
+	NotAfter  sql.NullTime `db:"not_after"`
+}
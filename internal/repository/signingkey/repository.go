@@ -0,0 +1,38 @@
+package signingkey
+
+import (
+	"context"
+	"database/sql"
+	"medods/database"
+	"medods/internal/repository"
+)
+
+type FieldName string
+
+const (
+	IdField        FieldName = "id"
+	AlgorithmField FieldName = "algorithm"
+	StatusField    FieldName = "status"
+	NotBeforeField FieldName = "not_before"
+)
+
+type SigningKeyRepository struct {
+	*repository.Repository[SigningKey, FieldName]
+}
+
+func NewRepository(db *database.Database, txOpts *sql.TxOptions) *SigningKeyRepository {
+	return &SigningKeyRepository{Repository: repository.NewRepository[SigningKey, FieldName](db, txOpts, "signing_keys")}
+}
+
+// GetAll return all keys ordered by start of validity
+func (r *SigningKeyRepository) GetAll(ctx context.Context) ([]SigningKey, error) {
+	return r.GetMany(ctx, repository.Query[FieldName]{}.OrderBy(NotBeforeField, false))
+}
+
+// Lock serialize rotations of replicas, lock is held until the end of transaction carried by ctx
+func (r *SigningKeyRepository) Lock(ctx context.Context) error {
+	return r.Tx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "signing_keys")
+		return err
+	})
+}
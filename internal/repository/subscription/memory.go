@@ -0,0 +1,8 @@
+package subscription
+
+import "medods/internal/repository"
+
+// NewMemoryRepository in-memory implementation of SubscriptionRepository
+func NewMemoryRepository() *repository.MemoryRepository[Subscription, FieldName] {
+	return repository.NewMemoryRepository[Subscription](CreatedAtField)
+}
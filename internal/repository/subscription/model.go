@@ -0,0 +1,14 @@
+package subscription
+
+import "time"
+
+// Subscription registered webhook endpoint
+type Subscription struct {
+	ID     string `db:"id"`
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+	// EventTypes comma separated types of events sent to endpoint
+	EventTypes string    `db:"event_types"`
+	Active     bool      `db:"active"`
+	CreatedAt  time.Time `db:"created_at"`
+}
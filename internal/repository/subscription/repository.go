@@ -0,0 +1,26 @@
+package subscription
+
+import (
+	"database/sql"
+	"medods/database"
+	"medods/internal/repository"
+)
+
+type FieldName string
+
+const (
+	IdField         FieldName = "id"
+	URLField        FieldName = "url"
+	SecretField     FieldName = "secret"
+	EventTypesField FieldName = "event_types"
+	ActiveField     FieldName = "active"
+	CreatedAtField  FieldName = "created_at"
+)
+
+type SubscriptionRepository struct {
+	*repository.Repository[Subscription, FieldName]
+}
+
+func NewRepository(db *database.Database, txOpts *sql.TxOptions) *SubscriptionRepository {
+	return &SubscriptionRepository{Repository: repository.NewRepository[Subscription](db, txOpts, "webhook_subscriptions", CreatedAtField)}
+}
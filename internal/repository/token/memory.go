@@ -0,0 +1,156 @@
+package token
+
+import (
+	"context"
+	"medods/internal/repository"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryRepository in-memory implementation of TokenRepository
+type MemoryRepository struct {
+	*repository.MemoryRepository[Token, FieldName]
+	// mu serialize operations which consist of several steps, as locks of database do
+	mu sync.Mutex
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{MemoryRepository: repository.NewMemoryRepository[Token](IdField, CreatedAtField)}
+}
+
+// Deactivate set active = false for all tokens matched by filters
+func (r *MemoryRepository) Deactivate(ctx context.Context, filters ...repository.Filter[FieldName]) error {
+	_, err := r.UpdateFields(ctx, map[FieldName]interface{}{ActiveField: false}, filters...)
+	return err
+}
+
+// Rotate deactivate old token and create its successor, only one of concurrent rotations succeeds
+func (r *MemoryRepository) Rotate(ctx context.Context, old *Token, created *Token) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n, err := r.UpdateFields(ctx, map[FieldName]interface{}{ActiveField: false},
+		repository.Eq(IdField, old.ID), repository.Eq(ActiveField, true))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotActive
+	}
+	return r.Create(ctx, created)
+}
+
+// DeactivateFamily deactivate all tokens of family
+func (r *MemoryRepository) DeactivateFamily(ctx context.Context, familyID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.Deactivate(ctx, repository.Eq(FamilyIdField, familyID), repository.Eq(ActiveField, true))
+}
+
+// CreateSession create first token of new session and evict the oldest sessions of user over maxSessions
+func (r *MemoryRepository) CreateSession(ctx context.Context, model *Token, maxSessions int) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	evicted := make([]string, 0)
+	if maxSessions > 0 {
+		sessions, err := r.activeSessions(ctx, model.UserGuid)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(sessions, func(i, j int) bool { return sessions[i].root.ID < sessions[j].root.ID })
+
+		for i := 0; len(sessions)-i >= maxSessions; i++ {
+			familyID := sessions[i].root.FamilyID
+			err = r.Deactivate(ctx, repository.Eq(FamilyIdField, familyID), repository.Eq(ActiveField, true))
+			if err != nil {
+				return nil, err
+			}
+			evicted = append(evicted, familyID)
+		}
+	}
+
+	if err := r.Create(ctx, model); err != nil {
+		return nil, err
+	}
+	return evicted, nil
+}
+
+// DeleteExpired remove sessions whose all tokens expired before moment and return number of removed tokens
+func (r *MemoryRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tokens, err := r.GetMany(ctx, repository.Query[FieldName]{})
+	if err != nil {
+		return 0, err
+	}
+	expired := make(map[string]bool)
+	for _, v := range tokens {
+		if _, ok := expired[v.FamilyID]; !ok {
+			expired[v.FamilyID] = true
+		}
+		if v.ExpiresAt.After(before) {
+			expired[v.FamilyID] = false
+		}
+	}
+	families := make([]string, 0, len(expired))
+	for k, v := range expired {
+		if v {
+			families = append(families, k)
+		}
+	}
+	if len(families) == 0 {
+		return 0, nil
+	}
+	return r.Delete(ctx, repository.In(FamilyIdField, families...))
+}
+
+// GetSessions return active sessions of user ordered by creation time
+func (r *MemoryRepository) GetSessions(ctx context.Context, userGuid string) ([]Session, error) {
+	active, err := r.activeSessions(ctx, userGuid)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].root.CreatedAt.Before(active[j].root.CreatedAt) })
+
+	sessions := make([]Session, len(active))
+	for i, v := range active {
+		sessions[i] = Session{
+			ID:              v.root.FamilyID,
+			CreatedAt:       v.root.CreatedAt,
+			LastRefreshedAt: v.current.CreatedAt,
+			IP:              v.current.IP,
+			UserAgent:       v.current.UserAgent,
+		}
+	}
+	return sessions, nil
+}
+
+type memorySession struct {
+	root    Token
+	current Token
+}
+
+// activeSessions return not expired active tokens of user with first tokens of their families
+func (r *MemoryRepository) activeSessions(ctx context.Context, userGuid string) ([]memorySession, error) {
+	active, err := r.GetMany(ctx, repository.Where(
+		repository.Eq(UserGuidField, userGuid),
+		repository.Eq(ActiveField, true),
+		repository.Gt(ExpiresAtField, time.Now())))
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]memorySession, 0, len(active))
+	for _, v := range active {
+		root, err := r.GetOne(ctx, repository.Where(repository.Eq(FamilyIdField, v.FamilyID), repository.Eq(GenerationField, 0)))
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, memorySession{root: *root, current: v})
+	}
+	return sessions, nil
+}
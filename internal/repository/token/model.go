@@ -1,11 +1,38 @@
 package token
 
+import (
+	"database/sql"
+	"time"
+)
+
 //go:generate go tool eos generator repository --type Token --default_id=true
 type Token struct {
 	ID           int64  `db:"id" eos:"autoincrement"`
 	UserGuid     string `db:"user_guid" eos:"user_guid"`
 	RefreshToken string `db:"refresh_token" eos:"refresh_token"`
 	Active       bool   `db:"active"`
-	UserAgent    string
-	IP           string
+	UserAgent    string `db:"user_agent"`
+	IP           string `db:"ip"`
+	// TokenKey key shared by payloads of access and refresh tokens of pair
+	TokenKey string `db:"token_key"`
+	// FamilyID lineage of refresh tokens produced by rotation of one token, it is also id of session
+	FamilyID   string        `db:"family_id"`
+	ParentID   sql.NullInt64 `db:"parent_id"`
+	Generation int           `db:"generation"`
+	CreatedAt  time.Time     `db:"created_at"`
+	// Selector public part of refresh token used for lookup
+	Selector string `db:"selector"`
+	// ExpiresAt end of idle timeout of token, it is never after end of session lifetime
+	ExpiresAt time.Time `db:"expires_at"`
+	// ClientID client which requested session, empty when tokens were issued without client
+	ClientID string `db:"client_id"`
+}
+
+// Session active session of user built from its first and current refresh tokens
+type Session struct {
+	ID              string    `db:"id"`
+	CreatedAt       time.Time `db:"created_at"`
+	LastRefreshedAt time.Time `db:"last_refreshed_at"`
+	IP              string    `db:"ip"`
+	UserAgent       string    `db:"user_agent"`
 }
@@ -3,15 +3,17 @@ package token
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
 	"medods/database"
 	"medods/internal/repository"
-	"strings"
+	"time"
 )
 
 type TokenRepository struct {
-	db     *database.Database
-	txOpts *sql.TxOptions
+	*repository.Repository[Token, FieldName]
 }
 
 type FieldName string
@@ -23,184 +25,177 @@ const (
 	ActiveField       FieldName = "active"
 	UserAgentField    FieldName = "user_agent"
 	IpField           FieldName = "ip"
+	TokenKeyField     FieldName = "token_key"
+	FamilyIdField     FieldName = "family_id"
+	ParentIdField     FieldName = "parent_id"
+	GenerationField   FieldName = "generation"
+	CreatedAtField    FieldName = "created_at"
+	SelectorField     FieldName = "selector"
+	ExpiresAtField    FieldName = "expires_at"
+	ClientIdField     FieldName = "client_id"
 )
 
-func NewRepository(db *database.Database) *TokenRepository {
-	return &TokenRepository{db: db}
+// ErrNotActive returned when token was deactivated by concurrent transaction
+var ErrNotActive = errors.New("token is not active")
+
+func NewRepository(db *database.Database, txOpts *sql.TxOptions) *TokenRepository {
+	return &TokenRepository{Repository: repository.NewRepository[Token](db, txOpts, "tokens", IdField, CreatedAtField)}
 }
 
-func create(tx *sql.Tx, model *Token) error {
-	_, err := tx.Exec("INSERT INTO tokens (user_guid,refresh_token,active,user_agent,ip) values ($1,$2,$3,$4,$5)",
-		model.UserGuid, model.RefreshToken, model.Active, model.UserAgent, model.IP)
+// Deactivate set active = false for all tokens matched by filters
+func (r *TokenRepository) Deactivate(ctx context.Context, filters ...repository.Filter[FieldName]) error {
+	_, err := r.UpdateFields(ctx, map[FieldName]interface{}{ActiveField: false}, filters...)
 	return err
 }
 
-func (r *TokenRepository) Create(ctx context.Context, model *Token) error {
-	return repository.TxRunner(ctx, r.db, func(tx *sql.Tx) error {
-		return create(tx, model)
-	})
+// lock serialize transactions working with the same key, lock is released at the end of transaction
+func lock(ctx context.Context, tx *sql.Tx, key string) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", key)
+	return err
 }
 
-func getOneBy(ctx context.Context, tx *sql.Tx, fields []FieldName, values []interface{}) (*Token, error) {
-	var token = new(Token)
-	if len(fields) < 1 || len(values) < 1 {
-		return nil, fmt.Errorf("len of values and fields must be not zero")
-	}
-
-	if len(fields) != len(values) {
-		return nil, fmt.Errorf("len of arguments is mismatch")
-	}
-
-	args := make([]string, len(fields))
-	for i, v := range fields {
-		args[i] = fmt.Sprintf("%s=$%d", v, i+1)
-	}
+// Rotate deactivate old token and create its successor in one transaction.
+// Only one of concurrent rotations of the same token succeeds, others get ErrNotActive
+func (r *TokenRepository) Rotate(ctx context.Context, old *Token, created *Token) error {
+	return r.Tx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if err := lock(ctx, tx, old.FamilyID); err != nil {
+			return fmt.Errorf("lock token family: %w", err)
+		}
 
-	whereArgs := strings.Join(args, " AND ")
+		//only one of concurrent transactions deactivates token, others see it inactive
+		n, err := r.UpdateFields(ctx, map[FieldName]interface{}{ActiveField: false},
+			repository.Eq(IdField, old.ID), repository.Eq(ActiveField, true))
+		if err != nil {
+			return fmt.Errorf("deactivate token: %w", err)
+		}
+		if n == 0 {
+			return ErrNotActive
+		}
 
-	q := fmt.Sprintf("SELECT * FROM tokens WHERE %s LIMIT 1", whereArgs)
+		return r.Create(ctx, created)
+	})
+}
 
-	row := tx.QueryRowContext(ctx, q, values...)
-	err := row.Scan(&token.ID, &token.UserGuid, &token.RefreshToken, &token.Active, &token.UserAgent, &token.IP)
-	if err != nil {
-		return nil, err
+func (r *TokenRepository) deactivateFamily(ctx context.Context, tx *sql.Tx, familyID string) error {
+	if err := lock(ctx, tx, familyID); err != nil {
+		return fmt.Errorf("lock token family: %w", err)
 	}
+	return r.Deactivate(ctx, repository.Eq(FamilyIdField, familyID), repository.Eq(ActiveField, true))
+}
 
-	return token, nil
+// DeactivateFamily deactivate all tokens of family. It waits for concurrent rotation of family,
+// so token created by rotation is deactivated too
+func (r *TokenRepository) DeactivateFamily(ctx context.Context, familyID string) error {
+	return r.Tx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return r.deactivateFamily(ctx, tx, familyID)
+	})
 }
 
-func (r *TokenRepository) GetOneBy(ctx context.Context, fields []FieldName, values []interface{}) (*Token, error) {
-	val, err := repository.GetTxRunner(ctx, r.db, func(tx *sql.Tx) (interface{}, error) {
-		return getOneBy(ctx, tx, fields, values)
+// CreateSession create first token of new session. When user already has maxSessions active sessions
+// the oldest ones are deactivated and their ids are returned, 0 means no limit.
+// Concurrent creations of sessions of the same user are serialized
+func (r *TokenRepository) CreateSession(ctx context.Context, model *Token, maxSessions int) ([]string, error) {
+	evicted := make([]string, 0)
+	err := r.Tx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if err := lock(ctx, tx, model.UserGuid); err != nil {
+			return fmt.Errorf("lock user sessions: %w", err)
+		}
+
+		if maxSessions > 0 {
+			//active sessions of user from the oldest one
+			q, args, err := activeSessions(model.UserGuid).Columns("t.family_id").OrderBy("root.id").ToSql()
+			if err != nil {
+				return fmt.Errorf("build query of active sessions: %w", err)
+			}
+
+			sessions := make([]string, 0)
+			rows, err := tx.QueryContext(ctx, q, args...)
+			if err != nil {
+				return fmt.Errorf("get active sessions: %w", err)
+			}
+			defer rows.Close()
+			if err = sqlx.StructScan(rows, &sessions); err != nil {
+				return fmt.Errorf("scan active sessions: %w", err)
+			}
+
+			//free place for new session
+			for i := 0; len(sessions)-i >= maxSessions; i++ {
+				if err = r.deactivateFamily(ctx, tx, sessions[i]); err != nil {
+					return fmt.Errorf("deactivate session %s: %w", sessions[i], err)
+				}
+				evicted = append(evicted, sessions[i])
+			}
+		}
+
+		return r.Create(ctx, model)
 	})
 	if err != nil {
 		return nil, err
 	}
-	res, ok := val.(*Token)
-	if !ok {
-		return nil, fmt.Errorf("get one by err: parse interface{} to *Token")
-	}
-	return res, nil
+	return evicted, nil
 }
 
-func update(ctx context.Context, updated *Token, tx *sql.Tx, whereFields []FieldName, whereValues []interface{}) error {
-	if len(whereFields) < 1 || len(whereValues) < 1 {
-		return fmt.Errorf("len of values and fields must be not zero")
-	}
-	if len(whereFields) != len(whereValues) {
-		return fmt.Errorf("len of arguments is mismatch")
-	}
-
-	//aggregate values for update
-	updatedValues := []interface{}{
-		updated.UserGuid,
-		updated.RefreshToken,
-		updated.Active,
-		updated.UserAgent,
-		updated.IP,
-	}
-
-	var numOfFields = 5
-
-	args := make([]string, len(whereFields))
-	for i, v := range whereFields {
-		args[i] = fmt.Sprintf("%s=$%d", v, numOfFields+i+1)
+// DeleteExpired remove sessions whose all tokens expired before moment and return number of removed tokens.
+// Whole families are removed, so references of parents are kept consistent
+func (r *TokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	families := repository.Builder.Select("family_id").
+		From("tokens").
+		GroupBy("family_id").
+		Having(sq.LtOrEq{"max(expires_at)": before})
+	q, args, err := repository.Builder.Delete("tokens").
+		Where(families.Prefix("family_id IN (").Suffix(")")).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build delete of expired tokens: %w", err)
 	}
 
-	whereArgs := strings.Join(args, " AND ")
-	whereStr := fmt.Sprintf("WHERE %s", whereArgs)
-
-	//aggregate all arguments for query
-	allValues := append(updatedValues, whereValues...)
-
-	//build sql query
-	q := fmt.Sprintf("UPDATE tokens SET user_guid = $1, refresh_token = $2, active = $3, user_agent=$4, ip= $5 %s", whereStr)
-
-	//execute sql query
-	_, err := tx.ExecContext(ctx, q, allValues...)
-	if err != nil {
+	var n int64
+	err = r.Tx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, q, args...)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
 		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete expired tokens: %w", err)
 	}
-
-	return nil
+	return n, nil
 }
 
-func (r *TokenRepository) Update(ctx context.Context, updated *Token, whereFields []FieldName, whereValues []interface{}) error {
-	return repository.TxRunner(ctx, r.db, func(tx *sql.Tx) error {
-		return update(ctx, updated, tx, whereFields, whereValues)
-	})
+// activeSessions select not expired active tokens of user joined with first tokens of their families
+func activeSessions(userGuid string) sq.SelectBuilder {
+	return repository.Builder.Select().
+		From("tokens t").
+		Join("tokens root ON root.family_id = t.family_id AND root.generation = 0").
+		Where(sq.Eq{"t.user_guid": userGuid, "t.active": true}).
+		Where("t.expires_at > now()")
 }
 
-func getManyBy(ctx context.Context, tx *sql.Tx, fields []FieldName, values []interface{}) ([]Token, error) {
-	tokens := make([]Token, 0)
-
-	if len(fields) < 1 || len(values) < 1 {
-		return nil, fmt.Errorf("len of values and fields must be not zero")
-	}
-
-	if len(fields) != len(values) {
-		return nil, fmt.Errorf("len of arguments is mismatch")
-	}
-	//build where conditions
-	args := make([]string, len(fields))
-	for i, v := range fields {
-		args[i] = fmt.Sprintf("%s=$%d", v, i+1)
-	}
+// GetSessions return active sessions of user ordered by creation time
+func (r *TokenRepository) GetSessions(ctx context.Context, userGuid string) ([]Session, error) {
+	sessions := make([]Session, 0)
 
-	whereArgs := strings.Join(args, " AND ")
-
-	q := fmt.Sprintf("SELECT * FROM tokens WHERE %s", whereArgs)
-
-	rows, err := tx.QueryContext(ctx, q, values...)
+	//first token of family keeps creation time of session, active one keeps time of last refresh
+	q, args, err := activeSessions(userGuid).
+		Columns("t.family_id AS id", "root.created_at", "t.created_at AS last_refreshed_at", "t.ip", "t.user_agent").
+		OrderBy("root.created_at").
+		ToSql()
 	if err != nil {
-		return tokens, fmt.Errorf("get tokens error: %w", err)
+		return nil, fmt.Errorf("build query of sessions: %w", err)
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var token Token
-		err = rows.Scan(
-			&token.ID,
-			&token.UserGuid,
-			&token.RefreshToken,
-			&token.Active,
-			&token.UserAgent,
-			&token.IP)
-		if err != nil {
-			return nil, fmt.Errorf("scan tokens error: %w", err)
-		}
-		tokens = append(tokens, token)
-	}
-	return tokens, nil
-}
-
-func (r *TokenRepository) GetManyBy(ctx context.Context, fields []FieldName, values []interface{}) (tokens []Token, err error) {
-	vals, err := repository.GetManyTxRunner(ctx, r.db, func(tx *sql.Tx) ([]interface{}, error) {
 
-		rawTokens, err := getManyBy(ctx, tx, fields, values)
+	err = r.Tx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, q, args...)
 		if err != nil {
-			return nil, err
+			return err
 		}
-
-		data := make([]interface{}, len(rawTokens))
-
-		for i, v := range rawTokens {
-			data[i] = v
-		}
-		return data, err
+		defer rows.Close()
+		return sqlx.StructScan(rows, &sessions)
 	})
-
 	if err != nil {
-		return nil, fmt.Errorf("GetManyBy: %w", err)
-	}
-
-	tokens = make([]Token, len(vals))
-	for i, v := range vals {
-		f, ok := v.(Token)
-		if !ok {
-			return nil, fmt.Errorf("convert interface{} to Token struct err")
-		}
-		tokens[i] = f
+		return nil, fmt.Errorf("get sessions error: %w", err)
 	}
-	return tokens, nil
+	return sessions, nil
 }
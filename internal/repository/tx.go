@@ -3,17 +3,47 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"github.com/lib/pq"
 	"medods/database"
+	"sync"
 )
 
+// codeSerializationFailure SQLSTATE of transaction which conflicts with concurrent one
+// under repeatable read or serializable isolation
+const codeSerializationFailure = "40001"
+
 type TxFunc func(tx *sql.Tx) error
-type GetTxFunc func(tx *sql.Tx) (interface{}, error)
 
-type GetManyTxFunc func(tx *sql.Tx) ([]interface{}, error)
+// IsSerializationFailure report whether err is caused by concurrent transaction, such transaction
+// wasn't applied and may be retried
+func IsSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == codeSerializationFailure
+}
+
+type txKey struct{}
+
+// WithTx return context carrying transaction, repositories called with this context join it
+func WithTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext return transaction carried by context
+func TxFromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return tx, ok && tx != nil
+}
+
+// TxRunner run txFunc in transaction carried by context or in new transaction started with txOpts
+func TxRunner(ctx context.Context, db *database.Database, txOpts *sql.TxOptions, txFunc TxFunc) (err error) {
+	//join transaction of unit of work, it is committed by its owner
+	if tx, ok := TxFromContext(ctx); ok {
+		return txFunc(tx)
+	}
 
-func TxRunner(ctx context.Context, db *database.Database, txFunc TxFunc) (err error) {
-	tx, err := db.BeginTx(ctx, nil)
+	tx, err := db.BeginTx(ctx, txOpts)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
@@ -32,42 +62,99 @@ func TxRunner(ctx context.Context, db *database.Database, txFunc TxFunc) (err er
 	return err
 }
 
-func GetTxRunner(ctx context.Context, db *database.Database, txFunc GetTxFunc) (interface{}, error) {
-	tx, err := db.BeginTx(ctx, nil)
+// Transactor run fn so that calls of repositories with context passed to it are atomic
+type Transactor interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// UnitOfWork run several calls of repositories in one transaction
+type UnitOfWork struct {
+	db     *database.Database
+	txOpts *sql.TxOptions
+}
+
+func NewUnitOfWork(db *database.Database, txOpts *sql.TxOptions) *UnitOfWork {
+	return &UnitOfWork{db: db, txOpts: txOpts}
+}
+
+// Do run fn in transaction, repositories called with context passed to fn join it.
+// Transaction is committed when fn returns nil and rolled back otherwise.
+// Nested calls join outer transaction, functions passed to AfterCommit run after commit of the outermost one
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+	hooks := &afterCommit{}
+	ctx = context.WithValue(ctx, afterCommitKey{}, hooks)
+	err := TxRunner(ctx, u.db, u.txOpts, func(tx *sql.Tx) error {
+		return fn(WithTx(ctx, tx))
+	})
 	if err != nil {
-		return nil, fmt.Errorf("begin tx: %w", err)
+		return err
 	}
-	defer func() {
-		if p := recover(); p != nil {
-			_ = tx.Rollback()
-			panic(p)
-		}
-		if err != nil {
-			_ = tx.Rollback()
-		} else {
-			err = tx.Commit()
-		}
-	}()
-	val, err := txFunc(tx)
-	return val, err
+	hooks.run()
+	return nil
 }
 
-func GetManyTxRunner(ctx context.Context, db *database.Database, txFunc GetManyTxFunc) ([]interface{}, error) {
-	tx, err := db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, fmt.Errorf("begin tx: %w", err)
+type afterCommitKey struct{}
+
+// afterCommit functions waiting for commit of unit of work
+type afterCommit struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+func (h *afterCommit) add(fn func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fns = append(h.fns, fn)
+}
+
+func (h *afterCommit) run() {
+	h.mu.Lock()
+	fns := h.fns
+	h.fns = nil
+	h.mu.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// AfterCommit run fn once unit of work carried by ctx is committed, fn is dropped when it is rolled back.
+// Without unit of work fn is run immediately
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		hooks.add(fn)
+		return
+	}
+	fn()
+}
+
+// Savepoint run fn in savepoint of transaction carried by ctx. Failure of fn rolls back only its changes,
+// so outer transaction can still be committed. Without transaction fn is run as is
+func Savepoint(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	tx, ok := TxFromContext(ctx)
+	if !ok {
+		return fn(ctx)
+	}
+	//savepoints with the same name are stacked, so nested calls release their own one
+	if _, err = tx.ExecContext(ctx, "SAVEPOINT nested"); err != nil {
+		return fmt.Errorf("create savepoint: %w", err)
 	}
 	defer func() {
 		if p := recover(); p != nil {
-			_ = tx.Rollback()
+			_, _ = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT nested")
 			panic(p)
 		}
 		if err != nil {
-			_ = tx.Rollback()
-		} else {
-			err = tx.Commit()
+			if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT nested"); rbErr != nil {
+				err = fmt.Errorf("%w, rollback to savepoint: %w", err, rbErr)
+			}
+			return
+		}
+		if _, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT nested"); err != nil {
+			err = fmt.Errorf("release savepoint: %w", err)
 		}
 	}()
-	val, err := txFunc(tx)
-	return val, err
+	return fn(ctx)
 }
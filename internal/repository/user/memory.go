@@ -0,0 +1,8 @@
+package user
+
+import "medods/internal/repository"
+
+// NewMemoryRepository in-memory implementation of UserRepository
+func NewMemoryRepository() *repository.MemoryRepository[User, FieldName] {
+	return repository.NewMemoryRepository[User, FieldName]()
+}
@@ -0,0 +1,13 @@
+package audit
+
+import (
+	"context"
+	"medods/internal/repository"
+	"medods/internal/repository/audit"
+)
+
+// AuditRepository append-only storage of audit events, implemented by *audit.AuditRepository and in-memory repository
+type AuditRepository interface {
+	Create(ctx context.Context, model *audit.Event) error
+	GetMany(ctx context.Context, q repository.Query[audit.FieldName]) ([]audit.Event, error)
+}
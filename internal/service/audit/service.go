@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	audit2 "medods/internal/domain/audit"
+	"medods/internal/domain/request"
+	"medods/internal/repository"
+	"medods/internal/repository/audit"
+	"time"
+)
+
+const (
+	defaultEventsLimit = 100
+	maxEventsLimit     = 1000
+	// exportPageSize number of events read at once during export
+	exportPageSize = 500
+)
+
+// AuditLogger append-only log of security events
+type AuditLogger struct {
+	repo AuditRepository
+}
+
+func NewAuditLogger(repo AuditRepository) *AuditLogger {
+	return &AuditLogger{repo: repo}
+}
+
+// Log record event, ip and user agent are taken from request carried by ctx when event has none.
+// Event joins transaction carried by ctx, so action and its record are stored together. Record is written
+// in savepoint, so its failure doesn't abort transaction of action: action isn't stopped, error is only logged
+func (l *AuditLogger) Log(ctx context.Context, e audit2.Event) {
+	if data, ok := request.FromContext(ctx); ok {
+		if e.IP == "" {
+			e.IP = data.IP
+		}
+		if e.UserAgent == "" {
+			e.UserAgent = data.UserAgent
+		}
+	}
+	err := repository.Savepoint(ctx, func(ctx context.Context) error {
+		return l.repo.Create(ctx, &audit.Event{
+			OccurredAt: time.Now(),
+			Type:       e.Type,
+			Outcome:    e.Outcome,
+			UserGuid:   e.UserGuid,
+			SessionID:  e.SessionID,
+			IP:         e.IP,
+			UserAgent:  e.UserAgent,
+			Reason:     e.Reason,
+		})
+	})
+	if err != nil {
+		log.Printf("audit event %s of user %s wasn't recorded: %s", e.Type, e.UserGuid, err)
+	}
+}
+
+// Events return page of events matched by filter, the newest go first
+func (l *AuditLogger) Events(ctx context.Context, f audit2.Filter) ([]audit2.Event, error) {
+	if f.Limit == 0 {
+		f.Limit = defaultEventsLimit
+	}
+	f.Limit = min(f.Limit, maxEventsLimit)
+
+	stored, err := l.repo.GetMany(ctx, repository.Where(filters(f)...).OrderBy(audit.IdField, true).Page(f.Limit, f.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("get audit events: %w", err)
+	}
+	res := make([]audit2.Event, len(stored))
+	for i := range stored {
+		res[i] = toEvent(&stored[i])
+	}
+	return res, nil
+}
+
+// Export write all events matched by filter to w as JSON Lines in order they occurred,
+// limit and offset of filter are ignored
+func (l *AuditLogger) Export(ctx context.Context, f audit2.Filter, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	var lastID int64
+	for {
+		//events are read by pages after last written one, so new events don't shift pages
+		page, err := l.repo.GetMany(ctx, repository.Where(append(filters(f), repository.Gt(audit.IdField, lastID))...).
+			OrderBy(audit.IdField, false).
+			Page(exportPageSize, 0))
+		if err != nil {
+			return fmt.Errorf("get audit events: %w", err)
+		}
+		for i := range page {
+			if err = enc.Encode(toEvent(&page[i])); err != nil {
+				return fmt.Errorf("write audit event: %w", err)
+			}
+			lastID = page[i].ID
+		}
+		if len(page) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+func filters(f audit2.Filter) []repository.Filter[audit.FieldName] {
+	res := make([]repository.Filter[audit.FieldName], 0, 4)
+	if f.UserGuid != "" {
+		res = append(res, repository.Eq(audit.UserGuidField, f.UserGuid))
+	}
+	if f.Type != "" {
+		res = append(res, repository.Eq(audit.TypeField, f.Type))
+	}
+	if !f.From.IsZero() {
+		res = append(res, repository.GtOrEq(audit.OccurredAtField, f.From))
+	}
+	if !f.To.IsZero() {
+		res = append(res, repository.Lt(audit.OccurredAtField, f.To))
+	}
+	return res
+}
+
+func toEvent(e *audit.Event) audit2.Event {
+	return audit2.Event{
+		ID:         e.ID,
+		OccurredAt: e.OccurredAt,
+		Type:       e.Type,
+		Outcome:    e.Outcome,
+		UserGuid:   e.UserGuid,
+		SessionID:  e.SessionID,
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+		Reason:     e.Reason,
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"fmt"
+	"go.dataddo.com/env"
+	"log"
+)
+
+type ClientConfig struct {
+	// Credentials of registered clients in format "client_id:secret,client_id2:secret2"
+	Credentials string `env:"CREDENTIALS"`
+	// AdminCredentials of operators managing webhooks and reading audit log, format is the same as of Credentials.
+	// They are separate from client credentials, so resource servers can't access data of all users
+	AdminCredentials string `env:"ADMIN_CREDENTIALS"`
+}
+
+func NewConfig() (*ClientConfig, error) {
+	var config ClientConfig
+	if err := env.Load(&config, "OAUTH_CLIENT_"); err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the oauth client config: %v", err)
+	}
+	//there are no default credentials, operators must set their own ones
+	if config.Credentials == "" {
+		return nil, fmt.Errorf("OAUTH_CLIENT_CREDENTIALS isn't set")
+	}
+	if config.AdminCredentials == "" {
+		return nil, fmt.Errorf("OAUTH_CLIENT_ADMIN_CREDENTIALS isn't set")
+	}
+	log.Printf("oauth client config was loaded successfully")
+	return &config, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidClient = errors.New("invalid client")
+
+// ClientService authenticate OAuth clients (resource servers) which call introspection and revocation endpoints
+// and administrators which manage webhooks and read audit log
+type ClientService struct {
+	secrets map[string][sha256.Size]byte
+	admins  map[string][sha256.Size]byte
+}
+
+func NewCService(conf *ClientConfig) (*ClientService, error) {
+	secrets, err := parseCredentials(conf.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	admins, err := parseCredentials(conf.AdminCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientService{secrets: secrets, admins: admins}, nil
+}
+
+// Authenticate check client secret in constant time
+func (cs *ClientService) Authenticate(clientID string, secret string) error {
+	return authenticate(cs.secrets, clientID, secret)
+}
+
+// AuthenticateAdmin check secret of administrator in constant time, client credentials aren't accepted
+func (cs *ClientService) AuthenticateAdmin(adminID string, secret string) error {
+	return authenticate(cs.admins, adminID, secret)
+}
+
+func authenticate(secrets map[string][sha256.Size]byte, id string, secret string) error {
+	expected, ok := secrets[id]
+	//compare with something anyway, so unknown client takes the same time
+	actual := sha256.Sum256([]byte(secret))
+	if subtle.ConstantTimeCompare(expected[:], actual[:]) != 1 || !ok {
+		return ErrInvalidClient
+	}
+	return nil
+}
+
+// parseCredentials parse credentials in format "id:secret,id2:secret2", secrets are kept hashed
+func parseCredentials(credentials string) (map[string][sha256.Size]byte, error) {
+	secrets := make(map[string][sha256.Size]byte)
+	if credentials == "" {
+		return secrets, nil
+	}
+	for _, v := range strings.Split(credentials, ",") {
+		id, secret, ok := strings.Cut(strings.TrimSpace(v), ":")
+		if !ok || id == "" || secret == "" {
+			return nil, fmt.Errorf("invalid client credentials format, expected client_id:secret")
+		}
+		secrets[id] = sha256.Sum256([]byte(secret))
+	}
+	return secrets, nil
+}
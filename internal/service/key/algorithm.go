@@ -0,0 +1,142 @@
+package key
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/gbrlsnchs/jwt/v3"
+	"medods/internal/domain/key"
+)
+
+const (
+	hmacSecretSize = 64
+	rsaKeyBits     = 2048
+)
+
+// supported algorithms of signing keys
+const (
+	HS256 = "HS256"
+	HS384 = "HS384"
+	HS512 = "HS512"
+	RS256 = "RS256"
+	RS384 = "RS384"
+	RS512 = "RS512"
+	ES256 = "ES256"
+	ES384 = "ES384"
+	ES512 = "ES512"
+	EdDSA = "EdDSA"
+)
+
+// IsSymmetric report whether alg is HMAC algorithm, tokens signed by it can be verified only by holder of secret
+func IsSymmetric(alg string) bool {
+	return alg == HS256 || alg == HS384 || alg == HS512
+}
+
+// generateSecret generate secret for algorithm, private keys are encoded as PKCS #8 DER
+func generateSecret(alg string) ([]byte, error) {
+	var priv crypto.PrivateKey
+	var err error
+	switch alg {
+	case HS256, HS384, HS512:
+		secret := make([]byte, hmacSecretSize)
+		if _, err = rand.Read(secret); err != nil {
+			return nil, err
+		}
+		return secret, nil
+	case RS256, RS384, RS512:
+		priv, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case ES256, ES384, ES512:
+		priv, err = ecdsa.GenerateKey(curveOf(alg), rand.Reader)
+	case EdDSA:
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %s", alg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return x509.MarshalPKCS8PrivateKey(priv)
+}
+
+// decodePEM return DER bytes of PEM block or secret as is if it is not PEM
+func decodePEM(secret []byte) []byte {
+	block, _ := pem.Decode(secret)
+	if block == nil {
+		return secret
+	}
+	return block.Bytes
+}
+
+// newAlgorithm build jwt algorithm of key and return public key for asymmetric algorithms
+func newAlgorithm(k key.SigningKey) (jwt.Algorithm, crypto.PublicKey, error) {
+	if IsSymmetric(k.Algorithm) {
+		switch k.Algorithm {
+		case HS256:
+			return jwt.NewHS256(k.Secret), nil, nil
+		case HS384:
+			return jwt.NewHS384(k.Secret), nil, nil
+		default:
+			return jwt.NewHS512(k.Secret), nil, nil
+		}
+	}
+
+	priv, err := x509.ParsePKCS8PrivateKey(k.Secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	switch k.Algorithm {
+	case RS256, RS384, RS512:
+		rsaKey, ok := priv.(*rsa.PrivateKey)
+		if !ok {
+			return nil, nil, fmt.Errorf("key is not RSA key")
+		}
+		opt := jwt.RSAPrivateKey(rsaKey)
+		switch k.Algorithm {
+		case RS256:
+			return jwt.NewRS256(opt), &rsaKey.PublicKey, nil
+		case RS384:
+			return jwt.NewRS384(opt), &rsaKey.PublicKey, nil
+		default:
+			return jwt.NewRS512(opt), &rsaKey.PublicKey, nil
+		}
+	case ES256, ES384, ES512:
+		ecKey, ok := priv.(*ecdsa.PrivateKey)
+		if !ok || ecKey.Curve != curveOf(k.Algorithm) {
+			return nil, nil, fmt.Errorf("key is not ECDSA key on curve for %s", k.Algorithm)
+		}
+		opt := jwt.ECDSAPrivateKey(ecKey)
+		switch k.Algorithm {
+		case ES256:
+			return jwt.NewES256(opt), &ecKey.PublicKey, nil
+		case ES384:
+			return jwt.NewES384(opt), &ecKey.PublicKey, nil
+		default:
+			return jwt.NewES512(opt), &ecKey.PublicKey, nil
+		}
+	case EdDSA:
+		edKey, ok := priv.(ed25519.PrivateKey)
+		if !ok {
+			return nil, nil, fmt.Errorf("key is not Ed25519 key")
+		}
+		return jwt.NewEd25519(jwt.Ed25519PrivateKey(edKey)), edKey.Public(), nil
+	}
+	return nil, nil, fmt.Errorf("unsupported algorithm %s", k.Algorithm)
+}
+
+func curveOf(alg string) elliptic.Curve {
+	switch alg {
+	case ES384:
+		return elliptic.P384()
+	case ES512:
+		return elliptic.P521()
+	default:
+		return elliptic.P256()
+	}
+}
@@ -0,0 +1,53 @@
+package key
+
+import (
+	"fmt"
+	"go.dataddo.com/env"
+	"log"
+	"time"
+)
+
+const (
+	defaultAlgorithm      = "HS512"
+	defaultReloadInterval = time.Minute
+	defaultGracePeriod    = 24 * time.Hour
+)
+
+type KeyRingConfig struct {
+	// Secrets static HMAC keys in format "kid:secret,kid2:secret2"
+	Secrets string `env:"SECRETS"`
+	// Dir directory with key files, name of file without extension is kid.
+	// Files contain HMAC secret or PKCS #8 PEM private key for asymmetric algorithms
+	Dir string `env:"DIR"`
+	// ActiveKid static key used for signing, other static keys are verify-only
+	ActiveKid string `env:"ACTIVE_KID"`
+	// Algorithm one of HS256, HS384, HS512, RS256, RS384, RS512, ES256, ES384, ES512, EdDSA
+	Algorithm      string        `env:"ALGORITHM"`
+	ReloadInterval time.Duration `env:"RELOAD_INTERVAL"`
+	// GracePeriod how long rotated key stays valid for verification
+	GracePeriod time.Duration `env:"GRACE_PERIOD"`
+	// LegacySecret HS512 secret of tokens issued before key ring, they have no kid header.
+	// It is used only for verification of such tokens until LegacyNotAfter
+	LegacySecret string `env:"LEGACY_SECRET"`
+	// LegacyNotAfter RFC 3339 moment when tokens without kid stop being valid, required with LegacySecret.
+	// It should cover lifetime of sessions started before key ring
+	LegacyNotAfter string `env:"LEGACY_NOT_AFTER"`
+}
+
+func NewConfig() (*KeyRingConfig, error) {
+	var config KeyRingConfig
+	if err := env.Load(&config, "KEYRING_"); err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the keyring config: %v", err)
+	}
+	if config.Algorithm == "" {
+		config.Algorithm = defaultAlgorithm
+	}
+	if config.ReloadInterval == 0 {
+		config.ReloadInterval = defaultReloadInterval
+	}
+	if config.GracePeriod == 0 {
+		config.GracePeriod = defaultGracePeriod
+	}
+	log.Printf("keyring config was loaded successfully")
+	return &config, nil
+}
@@ -0,0 +1,15 @@
+package key
+
+import (
+	"context"
+	"medods/internal/repository"
+	"medods/internal/repository/signingkey"
+)
+
+// SigningKeyRepository storage of signing keys, implemented by *signingkey.SigningKeyRepository and *signingkey.MemoryRepository
+type SigningKeyRepository interface {
+	Create(ctx context.Context, model *signingkey.SigningKey) error
+	GetAll(ctx context.Context) ([]signingkey.SigningKey, error)
+	Update(ctx context.Context, updated *signingkey.SigningKey, filters ...repository.Filter[signingkey.FieldName]) (int64, error)
+	Lock(ctx context.Context) error
+}
@@ -0,0 +1,46 @@
+package key
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"medods/internal/domain/key"
+)
+
+// toJWK convert public key to JWK
+func toJWK(kid string, alg string, pub crypto.PublicKey) (key.JWK, error) {
+	jwk := key.JWK{Kid: kid, Alg: alg, Use: "sig"}
+	switch p := pub.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encode(p.N.Bytes())
+		jwk.E = encode(big.NewInt(int64(p.E)).Bytes())
+	case *ecdsa.PublicKey:
+		ecdhKey, err := p.ECDH()
+		if err != nil {
+			return key.JWK{}, err
+		}
+		//uncompressed point: 0x04 || X || Y
+		raw := ecdhKey.Bytes()
+		size := (len(raw) - 1) / 2
+		jwk.Kty = "EC"
+		jwk.Crv = p.Curve.Params().Name
+		jwk.X = encode(raw[1 : 1+size])
+		jwk.Y = encode(raw[1+size:])
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encode(p)
+	default:
+		return key.JWK{}, fmt.Errorf("unsupported public key type %T", pub)
+	}
+	return jwk, nil
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,517 @@
+package key
+
+import (
+	"context"
+	"crypto"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/gbrlsnchs/jwt/v3"
+	"github.com/google/uuid"
+	"log"
+	"medods/internal/domain/key"
+	"medods/internal/repository"
+	"medods/internal/repository/signingkey"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// minimal pause between reloads caused by unknown kid
+	missReloadPause = 5 * time.Second
+)
+
+var (
+	ErrNoSigningKey = errors.New("no active signing key")
+	ErrUnknownKey   = errors.New("unknown signing key")
+	// ErrStaticKey key is loaded from configuration and can be changed only there
+	ErrStaticKey = errors.New("signing key is static, change KEYRING_ACTIVE_KID, KEYRING_SECRETS or KEYRING_DIR instead")
+)
+
+type KeyRing struct {
+	conf *KeyRingConfig
+	repo SigningKeyRepository
+	uow  repository.Transactor
+
+	mu   sync.RWMutex
+	keys map[string]entry
+	// legacy verify-only key of tokens without kid, nil when it isn't configured
+	legacy     *entry
+	lastReload time.Time
+}
+
+// entry key with parsed algorithm, pub is nil for symmetric keys.
+// Static keys are loaded from env or key files
+type entry struct {
+	key    key.SigningKey
+	alg    jwt.Algorithm
+	pub    crypto.PublicKey
+	static bool
+}
+
+func NewKeyRing(conf *KeyRingConfig, repo SigningKeyRepository, uow repository.Transactor) *KeyRing {
+	return &KeyRing{conf: conf, repo: repo, uow: uow, keys: make(map[string]entry)}
+}
+
+// Init load keys on startup and rotate active key if its algorithm differs from configured one
+func (kr *KeyRing) Init(ctx context.Context) error {
+	if err := kr.Load(ctx); err != nil {
+		return err
+	}
+	kr.mu.RLock()
+	current, _ := kr.signer(kr.keys, time.Now())
+	kr.mu.RUnlock()
+	if current.key.Algorithm == kr.conf.Algorithm {
+		return nil
+	}
+	log.Printf("active signing key algorithm %s differs from configured %s, rotating", current.key.Algorithm, kr.conf.Algorithm)
+	//other replica may have rotated it already
+	if _, err := kr.rotate(ctx, false); err != nil {
+		return err
+	}
+	return kr.Load(ctx)
+}
+
+// Load read keys from env, key files and database and replace content of ring.
+// If there is no key for signing then new one is generated and saved to database
+func (kr *KeyRing) Load(ctx context.Context) error {
+	keys, err := kr.load(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := kr.signer(keys, time.Now()); !ok {
+		if _, err = kr.rotate(ctx, false); err != nil {
+			return fmt.Errorf("bootstrap signing key: %w", err)
+		}
+		if keys, err = kr.load(ctx); err != nil {
+			return err
+		}
+	}
+	legacy, err := kr.legacyKey()
+	if err != nil {
+		return err
+	}
+	kr.mu.Lock()
+	kr.keys = keys
+	kr.legacy = legacy
+	kr.lastReload = time.Now()
+	kr.mu.Unlock()
+	return nil
+}
+
+func (kr *KeyRing) load(ctx context.Context) (map[string]entry, error) {
+	keys := make(map[string]entry)
+
+	stored, err := kr.repo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get signing keys from db: %w", err)
+	}
+	for _, v := range stored {
+		k, err := fromModel(v)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %s: %w", v.ID, err)
+		}
+		addEntry(keys, k, false)
+	}
+
+	//static keys have priority over keys from database
+	static, err := kr.loadStatic()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range static {
+		addEntry(keys, k, true)
+	}
+	if kr.conf.ActiveKid != "" && !keys[kr.conf.ActiveKid].static {
+		return nil, fmt.Errorf("active signing key %s isn't found among static keys", kr.conf.ActiveKid)
+	}
+	return keys, nil
+}
+
+// addEntry parse key and add it to keys, broken keys are skipped so they can't break the whole ring
+func addEntry(keys map[string]entry, k key.SigningKey, static bool) {
+	alg, pub, err := newAlgorithm(k)
+	if err != nil {
+		log.Printf("skip signing key %s: %s", k.ID, err)
+		return
+	}
+	keys[k.ID] = entry{key: k, alg: alg, pub: pub, static: static}
+}
+
+// loadStatic read keys from KEYRING_SECRETS and files of KEYRING_DIR
+func (kr *KeyRing) loadStatic() ([]key.SigningKey, error) {
+	var keys []key.SigningKey
+	if kr.conf.Secrets != "" {
+		for _, v := range strings.Split(kr.conf.Secrets, ",") {
+			kid, secret, ok := strings.Cut(strings.TrimSpace(v), ":")
+			if !ok || kid == "" || secret == "" {
+				return nil, fmt.Errorf("invalid static key format, expected kid:secret")
+			}
+			keys = append(keys, kr.staticKey(kid, []byte(secret)))
+		}
+	}
+	if kr.conf.Dir != "" {
+		entries, err := os.ReadDir(kr.conf.Dir)
+		if err != nil {
+			return nil, fmt.Errorf("read keys dir: %w", err)
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				continue
+			}
+			secret, err := os.ReadFile(filepath.Join(kr.conf.Dir, e.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("read key file: %w", err)
+			}
+			kid := strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+			//private keys of asymmetric algorithms are stored as PEM files
+			secret = decodePEM([]byte(strings.TrimSpace(string(secret))))
+			keys = append(keys, kr.staticKey(kid, secret))
+		}
+	}
+	return keys, nil
+}
+
+// legacyKey return key of tokens issued before key ring, it can't be found by kid
+func (kr *KeyRing) legacyKey() (*entry, error) {
+	if kr.conf.LegacySecret == "" {
+		return nil, nil
+	}
+	notAfter, err := time.Parse(time.RFC3339, kr.conf.LegacyNotAfter)
+	if err != nil {
+		return nil, fmt.Errorf("legacy key must have end of validity in RFC 3339 format: %w", err)
+	}
+	k := key.SigningKey{ID: "legacy", Algorithm: HS512, Secret: []byte(kr.conf.LegacySecret), Status: key.StatusVerifyOnly, NotAfter: &notAfter}
+	alg, _, err := newAlgorithm(k)
+	if err != nil {
+		return nil, err
+	}
+	return &entry{key: k, alg: alg, static: true}, nil
+}
+
+func (kr *KeyRing) staticKey(kid string, secret []byte) key.SigningKey {
+	status := key.StatusVerifyOnly
+	if kid == kr.conf.ActiveKid {
+		status = key.StatusActive
+	}
+	return key.SigningKey{ID: kid, Algorithm: kr.conf.Algorithm, Secret: secret, Status: status}
+}
+
+// Run periodically reload keys, so keys rotated by other replicas are picked up without restart
+func (kr *KeyRing) Run(ctx context.Context) {
+	ticker := time.NewTicker(kr.conf.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := kr.Load(ctx); err != nil {
+				log.Printf("reload signing keys error: %s", err)
+			}
+		}
+	}
+}
+
+// Rotate generate new active key with configured algorithm,
+// old active keys become verify-only until grace period ends.
+// Key configured by KEYRING_ACTIVE_KID can't be rotated, ErrStaticKey is returned
+func (kr *KeyRing) Rotate(ctx context.Context) (key.SigningKey, error) {
+	if kr.conf.ActiveKid != "" {
+		return key.SigningKey{}, ErrStaticKey
+	}
+	created, err := kr.rotate(ctx, true)
+	if err != nil {
+		return key.SigningKey{}, err
+	}
+	if err = kr.Load(ctx); err != nil {
+		return key.SigningKey{}, err
+	}
+	return created, nil
+}
+
+// rotate demote active keys and create new one in one transaction. Replicas rotate one by one,
+// without force the key created by other replica is kept when it is active and has configured algorithm
+func (kr *KeyRing) rotate(ctx context.Context, force bool) (key.SigningKey, error) {
+	secret, err := generateSecret(kr.conf.Algorithm)
+	if err != nil {
+		return key.SigningKey{}, fmt.Errorf("generate secret: %w", err)
+	}
+
+	var created key.SigningKey
+	err = kr.uow.Do(ctx, func(ctx context.Context) error {
+		if err := kr.repo.Lock(ctx); err != nil {
+			return fmt.Errorf("lock signing keys: %w", err)
+		}
+		stored, err := kr.repo.GetAll(ctx)
+		if err != nil {
+			return fmt.Errorf("get signing keys from db: %w", err)
+		}
+		now := time.Now()
+
+		if !force {
+			for _, v := range stored {
+				k, err := fromModel(v)
+				if err == nil && k.Algorithm == kr.conf.Algorithm && k.CanSign(now) {
+					created = k
+					return nil
+				}
+			}
+		}
+
+		//demote old active keys
+		graceEnd := now.Add(kr.conf.GracePeriod)
+		for _, v := range stored {
+			if key.Status(v.Status) != key.StatusActive {
+				continue
+			}
+			v.Status = string(key.StatusVerifyOnly)
+			if !v.NotAfter.Valid || v.NotAfter.Time.After(graceEnd) {
+				v.NotAfter = sql.NullTime{Time: graceEnd, Valid: true}
+			}
+			if _, err = kr.repo.Update(ctx, &v, repository.Eq(signingkey.IdField, v.ID)); err != nil {
+				return fmt.Errorf("demote signing key: %w", err)
+			}
+		}
+
+		created = key.SigningKey{
+			ID:        uuid.NewString(),
+			Algorithm: kr.conf.Algorithm,
+			Secret:    secret,
+			Status:    key.StatusActive,
+			NotBefore: now,
+		}
+		if err = kr.repo.Create(ctx, toModel(created)); err != nil {
+			return fmt.Errorf("save signing key: %w", err)
+		}
+		log.Printf("signing key %s was created", created.ID)
+		return nil
+	})
+	if err != nil {
+		return key.SigningKey{}, err
+	}
+	return created, nil
+}
+
+// Retire mark key as retired, tokens signed by it are not valid anymore.
+// Static keys can't be retired, ErrStaticKey is returned
+func (kr *KeyRing) Retire(ctx context.Context, kid string) error {
+	e, ok := kr.get(kid)
+	if !ok {
+		return ErrUnknownKey
+	}
+	if e.static {
+		return ErrStaticKey
+	}
+	k := e.key
+	k.Status = key.StatusRetired
+	_, err := kr.repo.Update(ctx, toModel(k), repository.Eq(signingkey.IdField, kid))
+	if err != nil {
+		return fmt.Errorf("retire signing key: %w", err)
+	}
+	return kr.Load(ctx)
+}
+
+// Keys return snapshot of all known keys
+func (kr *KeyRing) Keys() []key.SigningKey {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	keys := make([]key.SigningKey, 0, len(kr.keys))
+	for _, e := range kr.keys {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// JWKS return public keys of asymmetric keys which may be used for verification
+func (kr *KeyRing) JWKS() []key.JWK {
+	now := time.Now()
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	jwks := make([]key.JWK, 0, len(kr.keys))
+	for _, e := range kr.keys {
+		if e.pub == nil || !e.key.CanVerify(now) {
+			continue
+		}
+		jwk, err := toJWK(e.key.ID, e.key.Algorithm, e.pub)
+		if err != nil {
+			log.Printf("convert signing key %s to jwk: %s", e.key.ID, err)
+			continue
+		}
+		jwks = append(jwks, jwk)
+	}
+	sort.Slice(jwks, func(i, j int) bool { return jwks[i].Kid < jwks[j].Kid })
+	return jwks
+}
+
+// PublicAlgorithms return sorted algorithms of asymmetric keys which may be used for verification,
+// symmetric keys are never published
+func (kr *KeyRing) PublicAlgorithms() []string {
+	now := time.Now()
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	seen := make(map[string]bool)
+	algs := make([]string, 0)
+	for _, e := range kr.keys {
+		if e.pub == nil || seen[e.key.Algorithm] || !e.key.CanVerify(now) {
+			continue
+		}
+		seen[e.key.Algorithm] = true
+		algs = append(algs, e.key.Algorithm)
+	}
+	sort.Strings(algs)
+	return algs
+}
+
+// Signer return kid and algorithm of key configured by KEYRING_ACTIVE_KID or of newest active key
+func (kr *KeyRing) Signer() (string, jwt.Algorithm, error) {
+	kr.mu.RLock()
+	e, ok := kr.signer(kr.keys, time.Now())
+	kr.mu.RUnlock()
+	if !ok {
+		return "", nil, ErrNoSigningKey
+	}
+	return e.key.ID, e.alg, nil
+}
+
+// Verifier return algorithm for verification of tokens signed by key with kid
+func (kr *KeyRing) Verifier(kid string) (jwt.Algorithm, error) {
+	e, ok := kr.get(kid)
+	if !ok && kr.reloadOnMiss() {
+		e, ok = kr.get(kid)
+	}
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if !e.key.CanVerify(time.Now()) {
+		return nil, fmt.Errorf("signing key %s is not valid for verification", kid)
+	}
+	return e.alg, nil
+}
+
+// legacyVerifier return algorithm for verification of tokens without kid, they were issued before key ring
+func (kr *KeyRing) legacyVerifier() (jwt.Algorithm, error) {
+	kr.mu.RLock()
+	legacy := kr.legacy
+	kr.mu.RUnlock()
+	if legacy == nil {
+		return nil, errors.New("token has no kid header")
+	}
+	if !legacy.key.CanVerify(time.Now()) {
+		return nil, errors.New("tokens without kid aren't valid anymore")
+	}
+	return legacy.alg, nil
+}
+
+// Resolver return jwt.Algorithm which choose verification key by kid header of token
+func (kr *KeyRing) Resolver() jwt.Algorithm {
+	return &resolver{kr: kr}
+}
+
+func (kr *KeyRing) get(kid string) (entry, bool) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	e, ok := kr.keys[kid]
+	return e, ok
+}
+
+// reloadOnMiss load keys when token has unknown kid, it may be signed by key rotated on other replica
+func (kr *KeyRing) reloadOnMiss() bool {
+	kr.mu.RLock()
+	recently := time.Since(kr.lastReload) < missReloadPause
+	kr.mu.RUnlock()
+	if recently {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := kr.Load(ctx); err != nil {
+		log.Printf("reload signing keys error: %s", err)
+		return false
+	}
+	return true
+}
+
+type resolver struct {
+	jwt.Algorithm
+	kr *KeyRing
+}
+
+func (r *resolver) Resolve(hd jwt.Header) error {
+	if hd.KeyID == "" {
+		alg, err := r.kr.legacyVerifier()
+		if err != nil {
+			return err
+		}
+		r.Algorithm = alg
+		return nil
+	}
+	alg, err := r.kr.Verifier(hd.KeyID)
+	if err != nil {
+		return err
+	}
+	r.Algorithm = alg
+	return nil
+}
+
+// signer return configured active key, static keys have no start of validity
+// so they would never be chosen as the newest one
+func (kr *KeyRing) signer(keys map[string]entry, now time.Time) (entry, bool) {
+	if e, ok := keys[kr.conf.ActiveKid]; ok && e.static && e.key.CanSign(now) {
+		return e, true
+	}
+	return newestSigner(keys, now)
+}
+
+func newestSigner(keys map[string]entry, now time.Time) (entry, bool) {
+	var newest entry
+	var found bool
+	for _, e := range keys {
+		if !e.key.CanSign(now) {
+			continue
+		}
+		if !found || e.key.NotBefore.After(newest.key.NotBefore) {
+			newest = e
+			found = true
+		}
+	}
+	return newest, found
+}
+
+func fromModel(m signingkey.SigningKey) (key.SigningKey, error) {
+	secret, err := base64.StdEncoding.DecodeString(m.Secret)
+	if err != nil {
+		return key.SigningKey{}, fmt.Errorf("decode secret: %w", err)
+	}
+	k := key.SigningKey{
+		ID:        m.ID,
+		Algorithm: m.Algorithm,
+		Secret:    secret,
+		Status:    key.Status(m.Status),
+		NotBefore: m.NotBefore,
+	}
+	if m.NotAfter.Valid {
+		notAfter := m.NotAfter.Time
+		k.NotAfter = &notAfter
+	}
+	return k, nil
+}
+
+func toModel(k key.SigningKey) *signingkey.SigningKey {
+	m := &signingkey.SigningKey{
+		ID:        k.ID,
+		Algorithm: k.Algorithm,
+		Secret:    base64.StdEncoding.EncodeToString(k.Secret),
+		Status:    string(k.Status),
+		NotBefore: k.NotBefore,
+	}
+	if k.NotAfter != nil {
+		m.NotAfter = sql.NullTime{Time: *k.NotAfter, Valid: true}
+	}
+	return m
+}
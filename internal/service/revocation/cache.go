@@ -0,0 +1,143 @@
+package revocation
+
+import (
+	"context"
+	"log"
+	"medods/internal/repository"
+	"medods/internal/repository/blacklist"
+	"sync"
+	"time"
+)
+
+type entryKey struct {
+	field blacklist.FieldName
+	value string
+}
+
+// Cache in-process copy of not expired blacklist entries. It is loaded from database and then
+// kept up to date by notifications, while it isn't synced lookups must go to database
+type Cache struct {
+	conf    *Config
+	repo    BlacklistRepository
+	source  Source
+	mu      sync.RWMutex
+	entries map[entryKey]time.Time
+	synced  bool
+}
+
+func NewCache(conf *Config, repo BlacklistRepository, source Source) *Cache {
+	return &Cache{conf: conf, repo: repo, source: source, entries: make(map[entryKey]time.Time)}
+}
+
+// Revoked check that value of field is blacklisted, ok is false when cache can't answer
+func (c *Cache) Revoked(field blacklist.FieldName, value string) (revoked bool, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.synced {
+		return false, false
+	}
+	_, revoked = c.entries[entryKey{field: field, value: value}]
+	return revoked, true
+}
+
+// Add put entries to cache. Entries are never removed from blacklist before expiry,
+// so adding is safe in any order with loads and notifications
+func (c *Cache) Add(entries ...blacklist.Blacklist) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, v := range entries {
+		c.add(v)
+	}
+}
+
+func (c *Cache) add(e blacklist.Blacklist) {
+	keys := []entryKey{
+		{blacklist.AccessTokenFieldName, e.AccessToken},
+		{blacklist.TokenKeyFieldName, e.TokenKey},
+		{blacklist.JTIFieldName, e.JTI},
+	}
+	for _, k := range keys {
+		if k.value == "" {
+			continue
+		}
+		if expiresAt, ok := c.entries[k]; !ok || expiresAt.Before(e.ExpiresAt) {
+			c.entries[k] = e.ExpiresAt
+		}
+	}
+}
+
+// Run listen for created entries, load cache and keep it synced until ctx is done
+func (c *Cache) Run(ctx context.Context) error {
+	//listen first, so entries created during load aren't missed
+	created, err := c.source.Listen(ctx)
+	if err != nil {
+		return err
+	}
+	c.load(ctx)
+
+	ticker := time.NewTicker(c.conf.PruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-created:
+			if !ok {
+				return nil
+			}
+			if e == nil {
+				//notifications could be lost, database is used until cache is loaded again
+				c.setSynced(false)
+				c.load(ctx)
+				continue
+			}
+			c.Add(*e)
+		case <-ticker.C:
+			c.prune()
+			if !c.isSynced() {
+				c.load(ctx)
+			}
+		}
+	}
+}
+
+// load merge not expired entries of database to cache and mark it synced
+func (c *Cache) load(ctx context.Context) {
+	loaded, err := c.repo.GetMany(ctx, repository.Where(repository.Gt(blacklist.ExpiresAtFieldName, time.Now())))
+	if err != nil {
+		log.Printf("load revocation cache error: %s", err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, v := range loaded {
+		c.add(v)
+	}
+	c.synced = true
+	log.Printf("revocation cache is synced, %d entries", len(c.entries))
+}
+
+// prune drop entries of expired tokens
+func (c *Cache) prune() {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, expiresAt := range c.entries {
+		if expiresAt.Before(now) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+func (c *Cache) setSynced(synced bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.synced = synced
+}
+
+func (c *Cache) isSynced() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.synced
+}
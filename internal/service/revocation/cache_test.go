@@ -0,0 +1,197 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"medods/internal/repository"
+	"medods/internal/repository/blacklist"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRepository blacklist returned by loads of cache, err fails them
+type fakeRepository struct {
+	mu      sync.Mutex
+	entries []blacklist.Blacklist
+	err     error
+	loads   int
+}
+
+func (r *fakeRepository) GetMany(ctx context.Context, q repository.Query[blacklist.FieldName]) ([]blacklist.Blacklist, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loads++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return append([]blacklist.Blacklist(nil), r.entries...), nil
+}
+
+func (r *fakeRepository) set(err error, entries ...blacklist.Blacklist) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+	r.entries = append(r.entries, entries...)
+}
+
+func (r *fakeRepository) loadCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loads
+}
+
+// fakeSource notifications sent by test
+type fakeSource struct {
+	created chan *blacklist.Blacklist
+}
+
+func (s *fakeSource) Listen(ctx context.Context) (<-chan *blacklist.Blacklist, error) {
+	return s.created, nil
+}
+
+func newTestCache(repo *fakeRepository) (*Cache, *fakeSource) {
+	source := &fakeSource{created: make(chan *blacklist.Blacklist)}
+	return NewCache(&Config{PruneInterval: 10 * time.Millisecond}, repo, source), source
+}
+
+// run start cache until end of test, returned channel receives result of Run
+func run(t *testing.T, c *Cache) <-chan error {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	done := make(chan error, 1)
+	go func() { done <- c.Run(ctx) }()
+	return done
+}
+
+// wait poll cond until it is true or test times out
+func wait(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition isn't met")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func entry(jti string, ttl time.Duration) blacklist.Blacklist {
+	return blacklist.Blacklist{JTI: jti, ExpiresAt: time.Now().Add(ttl)}
+}
+
+func TestCacheFallbackBeforeLoad(t *testing.T) {
+	c, _ := newTestCache(&fakeRepository{})
+	c.Add(entry("added", time.Hour))
+	//even added entries aren't reported until cache is synced, database must be asked
+	for _, jti := range []string{"added", "unknown"} {
+		if revoked, ok := c.Revoked(blacklist.JTIFieldName, jti); revoked || ok {
+			t.Fatalf("%s: revoked %t, ok %t", jti, revoked, ok)
+		}
+	}
+}
+
+func TestCacheLoad(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.set(nil, blacklist.Blacklist{JTI: "jti", TokenKey: "key", AccessToken: "token", ExpiresAt: time.Now().Add(time.Hour)})
+	c, _ := newTestCache(repo)
+	c.load(context.Background())
+
+	for field, value := range map[blacklist.FieldName]string{
+		blacklist.JTIFieldName:         "jti",
+		blacklist.TokenKeyFieldName:    "key",
+		blacklist.AccessTokenFieldName: "token",
+	} {
+		if revoked, ok := c.Revoked(field, value); !revoked || !ok {
+			t.Fatalf("%s: revoked %t, ok %t", field, revoked, ok)
+		}
+	}
+	if revoked, ok := c.Revoked(blacklist.JTIFieldName, "unknown"); revoked || !ok {
+		t.Fatalf("unknown: revoked %t, ok %t", revoked, ok)
+	}
+	c.Add(entry("added", time.Hour))
+	if revoked, ok := c.Revoked(blacklist.JTIFieldName, "added"); !revoked || !ok {
+		t.Fatalf("added: revoked %t, ok %t", revoked, ok)
+	}
+}
+
+func TestCacheFailedLoad(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.set(errors.New("connection refused"))
+	c, _ := newTestCache(repo)
+	c.load(context.Background())
+	if _, ok := c.Revoked(blacklist.JTIFieldName, "jti"); ok {
+		t.Fatal("cache answers after failed load")
+	}
+}
+
+func TestCacheRun(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.set(nil, entry("stored", time.Hour))
+	c, source := newTestCache(repo)
+	run(t, c)
+	wait(t, func() bool {
+		revoked, _ := c.Revoked(blacklist.JTIFieldName, "stored")
+		return revoked
+	})
+
+	//entry of other replica comes by notification
+	notified := entry("notified", time.Hour)
+	source.created <- &notified
+	wait(t, func() bool {
+		revoked, _ := c.Revoked(blacklist.JTIFieldName, "notified")
+		return revoked
+	})
+}
+
+func TestCacheMissedNotifications(t *testing.T) {
+	repo := &fakeRepository{}
+	c, source := newTestCache(repo)
+	run(t, c)
+	wait(t, func() bool {
+		_, ok := c.Revoked(blacklist.JTIFieldName, "lost")
+		return ok
+	})
+
+	//notifications were lost and database isn't available, lookups fall back to database
+	repo.set(errors.New("connection refused"), entry("lost", time.Hour))
+	source.created <- nil
+	wait(t, func() bool { return repo.loadCount() >= 2 })
+	if _, ok := c.Revoked(blacklist.JTIFieldName, "lost"); ok {
+		t.Fatal("cache answers while notifications are lost")
+	}
+
+	//failed load is retried, lost entry is picked up
+	repo.set(nil)
+	wait(t, func() bool {
+		revoked, ok := c.Revoked(blacklist.JTIFieldName, "lost")
+		return revoked && ok
+	})
+}
+
+func TestCachePrune(t *testing.T) {
+	c, _ := newTestCache(&fakeRepository{})
+	c.load(context.Background())
+	c.Add(entry("expired", -time.Second), entry("valid", time.Hour))
+	c.prune()
+	if revoked, _ := c.Revoked(blacklist.JTIFieldName, "expired"); revoked {
+		t.Fatal("expired entry isn't pruned")
+	}
+	if revoked, _ := c.Revoked(blacklist.JTIFieldName, "valid"); !revoked {
+		t.Fatal("valid entry is pruned")
+	}
+}
+
+func TestCacheStopsWhenSourceIsClosed(t *testing.T) {
+	c, source := newTestCache(&fakeRepository{})
+	done := run(t, c)
+	close(source.created)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("cache isn't stopped")
+	}
+}
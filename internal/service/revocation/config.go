@@ -0,0 +1,29 @@
+package revocation
+
+import (
+	"fmt"
+	"go.dataddo.com/env"
+	"log"
+	"time"
+)
+
+const defaultPruneInterval = time.Minute
+
+type Config struct {
+	// Disabled every check of access token looks up blacklist in database
+	Disabled bool `env:"DISABLED"`
+	// PruneInterval how often expired entries are dropped and failed loads are retried
+	PruneInterval time.Duration `env:"PRUNE_INTERVAL"`
+}
+
+func NewConfig() (*Config, error) {
+	var config Config
+	if err := env.Load(&config, "REVOCATION_CACHE_"); err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the revocation cache config: %v", err)
+	}
+	if config.PruneInterval == 0 {
+		config.PruneInterval = defaultPruneInterval
+	}
+	log.Printf("revocation cache config was loaded successfully")
+	return &config, nil
+}
@@ -0,0 +1,18 @@
+package revocation
+
+import (
+	"context"
+	"medods/internal/repository"
+	"medods/internal/repository/blacklist"
+)
+
+// BlacklistRepository storage of blacklist, cache is loaded from it
+type BlacklistRepository interface {
+	GetMany(ctx context.Context, q repository.Query[blacklist.FieldName]) ([]blacklist.Blacklist, error)
+}
+
+// Source deliver entries created by all replicas. Nil is sent when notifications could be lost,
+// channel is closed when ctx is done
+type Source interface {
+	Listen(ctx context.Context) (<-chan *blacklist.Blacklist, error)
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"fmt"
+	"go.dataddo.com/env"
+	"log"
+	"time"
+)
+
+const (
+	defaultIssuer     = "http://localhost:8080"
+	defaultAudience   = "localhost:8080"
+	defaultIDTokenTTL = time.Hour
+
+	defaultAccessTokenTTL     = 24 * time.Hour
+	defaultRefreshIdleTimeout = 7 * 24 * time.Hour
+	defaultSessionMaxLifetime = 30 * 24 * time.Hour
+	defaultJanitorInterval    = 10 * time.Minute
+	defaultReuseGracePeriod   = 5 * time.Second
+)
+
+type TokenConfig struct {
+	// Issuer public base url of service, used as iss claim and in OpenID Connect discovery
+	Issuer     string        `env:"ISSUER"`
+	Audience   string        `env:"AUDIENCE"`
+	IDTokenTTL time.Duration `env:"ID_TOKEN_TTL"`
+	// MaxSessions limit of concurrent sessions per user, oldest session is evicted when exceeded. 0 means no limit
+	MaxSessions    int           `env:"MAX_SESSIONS"`
+	AccessTokenTTL time.Duration `env:"ACCESS_TOKEN_TTL"`
+	// RefreshIdleTimeout sliding lifetime of refresh token, every refresh extends session by this time
+	RefreshIdleTimeout time.Duration `env:"REFRESH_IDLE_TIMEOUT"`
+	// SessionMaxLifetime absolute lifetime of session, refresh can't extend session beyond it
+	SessionMaxLifetime time.Duration `env:"SESSION_MAX_LIFETIME"`
+	// JanitorInterval how often blacklist entries of expired tokens are removed
+	JanitorInterval time.Duration `env:"JANITOR_INTERVAL"`
+	// ReuseGracePeriod time after rotation during which rotated token is treated as used by concurrent refresh,
+	// it is refused with conflict instead of revocation of session as reused one
+	ReuseGracePeriod time.Duration `env:"REUSE_GRACE_PERIOD"`
+}
+
+func NewConfig() (*TokenConfig, error) {
+	var config TokenConfig
+	if err := env.Load(&config, "TOKEN_"); err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the token config: %v", err)
+	}
+	if config.Issuer == "" {
+		config.Issuer = defaultIssuer
+	}
+	if config.Audience == "" {
+		config.Audience = defaultAudience
+	}
+	if config.IDTokenTTL == 0 {
+		config.IDTokenTTL = defaultIDTokenTTL
+	}
+	if config.AccessTokenTTL == 0 {
+		config.AccessTokenTTL = defaultAccessTokenTTL
+	}
+	if config.RefreshIdleTimeout == 0 {
+		config.RefreshIdleTimeout = defaultRefreshIdleTimeout
+	}
+	if config.SessionMaxLifetime == 0 {
+		config.SessionMaxLifetime = defaultSessionMaxLifetime
+	}
+	if config.JanitorInterval == 0 {
+		config.JanitorInterval = defaultJanitorInterval
+	}
+	if config.ReuseGracePeriod == 0 {
+		config.ReuseGracePeriod = defaultReuseGracePeriod
+	}
+	log.Printf("token config was loaded successfully")
+	return &config, nil
+}
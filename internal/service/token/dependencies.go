@@ -0,0 +1,47 @@
+package token
+
+import (
+	"context"
+	"medods/internal/domain/audit"
+	"medods/internal/repository"
+	"medods/internal/repository/blacklist"
+	token2 "medods/internal/repository/token"
+	"time"
+)
+
+// TokenRepository storage of refresh tokens, implemented by *token2.TokenRepository and *token2.MemoryRepository
+type TokenRepository interface {
+	Create(ctx context.Context, model *token2.Token) error
+	GetOne(ctx context.Context, q repository.Query[token2.FieldName]) (*token2.Token, error)
+	GetMany(ctx context.Context, q repository.Query[token2.FieldName]) ([]token2.Token, error)
+	Deactivate(ctx context.Context, filters ...repository.Filter[token2.FieldName]) error
+	Rotate(ctx context.Context, old *token2.Token, created *token2.Token) error
+	DeactivateFamily(ctx context.Context, familyID string) error
+	CreateSession(ctx context.Context, model *token2.Token, maxSessions int) ([]string, error)
+	GetSessions(ctx context.Context, userGuid string) ([]token2.Session, error)
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// BlacklistRepository storage of blocked access tokens
+type BlacklistRepository interface {
+	Create(ctx context.Context, model *blacklist.Blacklist) error
+	GetOne(ctx context.Context, q repository.Query[blacklist.FieldName]) (*blacklist.Blacklist, error)
+	GetMany(ctx context.Context, q repository.Query[blacklist.FieldName]) ([]blacklist.Blacklist, error)
+	Delete(ctx context.Context, filters ...repository.Filter[blacklist.FieldName]) (int64, error)
+}
+
+// RevocationCache local copy of blacklist, ok is false when cache can't answer and database must be used
+type RevocationCache interface {
+	Revoked(field blacklist.FieldName, value string) (revoked bool, ok bool)
+	Add(entries ...blacklist.Blacklist)
+}
+
+// Outbox queue of webhooks about security events, event is stored in transaction carried by ctx
+type Outbox interface {
+	Publish(ctx context.Context, eventType string, data interface{}) error
+}
+
+// AuditLogger append-only log of security events
+type AuditLogger interface {
+	Log(ctx context.Context, e audit.Event)
+}
@@ -0,0 +1,109 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"medods/internal/domain/token"
+	"medods/internal/repository"
+	token2 "medods/internal/repository/token"
+	"time"
+)
+
+const (
+	TokenTypeAccess  = "access_token"
+	TokenTypeRefresh = "refresh_token"
+	// TokenTypeBearer type of access token reported by introspection
+	TokenTypeBearer = "Bearer"
+)
+
+// Introspect return state of access or refresh token as described in RFC 7662. Hint only defines
+// which type is looked up first, search is extended to the other type when token isn't found.
+// Invalid tokens are reported as inactive without error
+func (ts *TokenService) Introspect(ctx context.Context, tokenStr string, tokenTypeHint string) (token.Introspection, error) {
+	introspectors := []func(context.Context, string) (token.Introspection, error){ts.introspectAccessToken, ts.introspectRefreshToken}
+	if tokenTypeHint == TokenTypeRefresh {
+		introspectors[0], introspectors[1] = introspectors[1], introspectors[0]
+	}
+	for _, introspect := range introspectors {
+		res, err := introspect(ctx, tokenStr)
+		if err != nil || res.Active {
+			return res, err
+		}
+	}
+	return token.Introspection{}, nil
+}
+
+// introspectAccessToken check signature, expiration and blacklist of access token
+func (ts *TokenService) introspectAccessToken(ctx context.Context, tokenStr string) (token.Introspection, error) {
+	var pl token.AccessTokenPayload
+	if err := ts.Valid(tokenStr, &pl); err != nil {
+		return token.Introspection{}, nil
+	}
+	if !ts.VerifyToken(ctx, tokenStr) {
+		return token.Introspection{}, nil
+	}
+
+	res := token.Introspection{
+		Active:    true,
+		TokenType: TokenTypeBearer,
+		Subject:   pl.Subject,
+		ClientID:  pl.ClientID,
+		SessionID: pl.SessionID,
+		JWTID:     pl.JWTID,
+		Issuer:    pl.Issuer,
+		Audience:  pl.Audience,
+	}
+	if pl.ExpirationTime != nil {
+		res.ExpiresAt = pl.ExpirationTime.Time
+	}
+	if pl.IssuedAt != nil {
+		res.IssuedAt = pl.IssuedAt.Time
+	}
+
+	//session metadata
+	if pl.SessionID == "" {
+		return res, nil
+	}
+	session, err := ts.tRepo.GetOne(ctx, repository.Where(repository.Eq(token2.FamilyIdField, pl.SessionID), repository.Eq(token2.ActiveField, true)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return token.Introspection{}, fmt.Errorf("get session: %w", err)
+	default:
+		res.UserAgent = session.UserAgent
+		res.IP = session.IP
+	}
+	return res, nil
+}
+
+// introspectRefreshToken look up refresh token by selector and check its verifier and expiration
+func (ts *TokenService) introspectRefreshToken(ctx context.Context, tokenStr string) (token.Introspection, error) {
+	selector, verifier, err := parseRefreshToken(tokenStr)
+	if err != nil {
+		return token.Introspection{}, nil
+	}
+	stored, err := ts.tRepo.GetOne(ctx, repository.Where(repository.Eq(token2.SelectorField, selector), repository.Eq(token2.ActiveField, true)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+		return token.Introspection{}, nil
+	case err != nil:
+		return token.Introspection{}, fmt.Errorf("get refresh token: %w", err)
+	}
+	if !compareVerifier(verifier, stored.RefreshToken) || time.Now().After(stored.ExpiresAt) {
+		return token.Introspection{}, nil
+	}
+
+	return token.Introspection{
+		Active:    true,
+		Subject:   stored.UserGuid,
+		ClientID:  stored.ClientID,
+		SessionID: stored.FamilyID,
+		Issuer:    ts.conf.Issuer,
+		ExpiresAt: stored.ExpiresAt,
+		IssuedAt:  stored.CreatedAt,
+		UserAgent: stored.UserAgent,
+		IP:        stored.IP,
+	}, nil
+}
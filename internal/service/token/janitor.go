@@ -0,0 +1,40 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"medods/internal/repository"
+	"medods/internal/repository/blacklist"
+	"time"
+)
+
+// PurgeBlacklist remove blacklist entries of tokens which are expired anyway and return number of removed entries
+func (ts *TokenService) PurgeBlacklist(ctx context.Context) (int64, error) {
+	n, err := ts.blRepo.Delete(ctx, repository.Lt(blacklist.ExpiresAtFieldName, time.Now()))
+	if err != nil {
+		return 0, fmt.Errorf("purge blacklist: %w", err)
+	}
+	return n, nil
+}
+
+// RunJanitor periodically purge blacklist until ctx is done
+func (ts *TokenService) RunJanitor(ctx context.Context) {
+	ticker := time.NewTicker(ts.conf.JanitorInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := ts.PurgeBlacklist(ctx)
+			if err != nil {
+				log.Printf("blacklist janitor error: %s", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("blacklist janitor removed %d entries", n)
+			}
+		}
+	}
+}
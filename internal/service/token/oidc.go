@@ -0,0 +1,114 @@
+package token
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/gbrlsnchs/jwt/v3"
+	"hash"
+	"medods/internal/domain/oidc"
+	"medods/internal/domain/token"
+	"medods/internal/service/key"
+	"strings"
+	"time"
+)
+
+const ScopeOpenID = "openid"
+
+// ErrSymmetricSigningKey id token signed by HMAC key can't be verified by relying party without sharing the secret
+var ErrSymmetricSigningKey = errors.New("id tokens aren't issued with symmetric signing key")
+
+// CheckIDTokenSigner check that active signing key may be used for id tokens
+func (ts *TokenService) CheckIDTokenSigner() error {
+	_, alg, err := ts.keys.Signer()
+	if err != nil {
+		return fmt.Errorf("get signing key: %w", err)
+	}
+	if key.IsSymmetric(alg.Name()) {
+		return ErrSymmetricSigningKey
+	}
+	return nil
+}
+
+// BuildIDToken build OpenID Connect id token for user which got access token.
+// ErrSymmetricSigningKey is returned when active signing key is symmetric
+func (ts *TokenService) BuildIDToken(userGuid string, accessToken string, params oidc.IDTokenParams) (string, error) {
+	timeNow := time.Now()
+
+	kid, alg, err := ts.keys.Signer()
+	if err != nil {
+		return "", fmt.Errorf("get signing key: %w", err)
+	}
+	if key.IsSymmetric(alg.Name()) {
+		return "", ErrSymmetricSigningKey
+	}
+
+	audience := params.Audience
+	if audience == "" {
+		audience = ts.conf.Audience
+	}
+
+	atHash, err := accessTokenHash(alg.Name(), accessToken)
+	if err != nil {
+		return "", err
+	}
+
+	pl := token.IDTokenPayload{
+		Payload: jwt.Payload{
+			Issuer:         ts.conf.Issuer,
+			Subject:        userGuid,
+			Audience:       jwt.Audience{audience},
+			ExpirationTime: jwt.NumericDate(timeNow.Add(ts.conf.IDTokenTTL)),
+			IssuedAt:       jwt.NumericDate(timeNow),
+		},
+		AuthTime: jwt.NumericDate(timeNow),
+		Nonce:    params.Nonce,
+		AtHash:   atHash,
+	}
+	signed, err := jwt.Sign(pl, alg, jwt.KeyID(kid))
+	if err != nil {
+		return "", fmt.Errorf("jwt sign err %w", err)
+	}
+	return string(signed), nil
+}
+
+// Discovery return OpenID Connect provider metadata.
+// Authorize and refresh endpoints are custom, so no standard response type or grant is advertised,
+// lists are empty rather than omitted, because omitted grant types default to authorization_code and implicit
+func (ts *TokenService) Discovery() oidc.ProviderMetadata {
+	issuer := strings.TrimSuffix(ts.conf.Issuer, "/")
+	return oidc.ProviderMetadata{
+		Issuer:                           ts.conf.Issuer,
+		AuthorizationEndpoint:            issuer + "/authorize",
+		TokenEndpoint:                    issuer + "/refresh",
+		UserinfoEndpoint:                 issuer + "/user/userinfo",
+		JwksUri:                          issuer + "/.well-known/jwks.json",
+		ScopesSupported:                  []string{ScopeOpenID},
+		ResponseTypesSupported:           []string{},
+		GrantTypesSupported:              []string{},
+		SubjectTypesSupported:            []string{"public"},
+		IdTokenSigningAlgValuesSupported: ts.keys.PublicAlgorithms(),
+		ClaimsSupported:                  []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "at_hash"},
+	}
+}
+
+// accessTokenHash calculate at_hash claim: left half of hash of access token,
+// hash function depends on signing algorithm
+func accessTokenHash(algName string, accessToken string) (string, error) {
+	var h hash.Hash
+	switch {
+	case strings.HasSuffix(algName, "256"):
+		h = sha256.New()
+	case strings.HasSuffix(algName, "384"):
+		h = sha512.New384()
+	case strings.HasSuffix(algName, "512"), algName == "EdDSA":
+		h = sha512.New()
+	default:
+		return "", fmt.Errorf("unsupported algorithm %s", algName)
+	}
+	h.Write([]byte(accessToken))
+	sum := h.Sum(nil)
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2]), nil
+}
@@ -0,0 +1,95 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"medods/internal/domain/audit"
+	"medods/internal/domain/token"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/repository"
+	token2 "medods/internal/repository/token"
+)
+
+// ErrClientMismatch token was issued to other client than the one which requested its revocation
+var ErrClientMismatch = errors.New("token was issued to another client")
+
+// Revoke revoke access or refresh token issued to client as described in RFC 7009.
+// Hint only defines which type is tried first, unknown and invalid tokens are ignored.
+// Token of other client isn't revoked, ErrClientMismatch is returned
+func (ts *TokenService) Revoke(ctx context.Context, clientID string, tokenStr string, tokenTypeHint string) error {
+	revokers := []func(context.Context, string, string) (bool, error){ts.revokeAccessToken, ts.revokeRefreshToken}
+	if tokenTypeHint == TokenTypeRefresh {
+		revokers[0], revokers[1] = revokers[1], revokers[0]
+	}
+	for _, revoke := range revokers {
+		revoked, err := revoke(ctx, clientID, tokenStr)
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return nil
+		}
+	}
+	return nil
+}
+
+// revokeAccessToken add valid access token of client to blacklist
+func (ts *TokenService) revokeAccessToken(ctx context.Context, clientID string, accessToken string) (bool, error) {
+	var pl token.AccessTokenPayload
+	if err := ts.Valid(accessToken, &pl); err != nil {
+		return false, nil
+	}
+	if pl.ClientID != clientID {
+		return false, ErrClientMismatch
+	}
+
+	//token is already blocked
+	if !ts.VerifyToken(ctx, accessToken) {
+		return true, nil
+	}
+
+	entry := ts.blacklistEntry(accessToken, &pl)
+	if err := ts.blRepo.Create(ctx, entry); err != nil {
+		return false, fmt.Errorf("block access token: %w", err)
+	}
+	ts.cacheRevoked(ctx, *entry)
+	ts.audit.Log(ctx, audit.Event{
+		Type:      audit.EventTokenRevoked,
+		Outcome:   audit.OutcomeSuccess,
+		UserGuid:  pl.Subject,
+		SessionID: pl.SessionID,
+		Reason:    webhook2.RevokeReasonRevoked,
+	})
+	return true, nil
+}
+
+// revokeRefreshToken revoke session of refresh token of client, access tokens issued in it are blocked too
+func (ts *TokenService) revokeRefreshToken(ctx context.Context, clientID string, refreshToken string) (bool, error) {
+	selector, verifier, err := parseRefreshToken(refreshToken)
+	if err != nil {
+		return false, nil
+	}
+
+	stored, err := ts.tRepo.GetOne(ctx, repository.Where(repository.Eq(token2.SelectorField, selector), repository.Eq(token2.ActiveField, true)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("get refresh token: %w", err)
+	}
+
+	if !compareVerifier(verifier, stored.RefreshToken) {
+		return false, nil
+	}
+	if stored.ClientID != clientID {
+		return false, ErrClientMismatch
+	}
+
+	//revoke whole session, so token created by concurrent rotation is revoked too
+	if err = ts.revokeFamily(ctx, stored.FamilyID, webhook2.RevokeReasonRevoked); err != nil {
+		return false, err
+	}
+	return true, nil
+}
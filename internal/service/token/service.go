@@ -2,59 +2,86 @@ package token
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"database/sql"
 	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/gbrlsnchs/jwt/v3"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
-	"log"
-	"medods/internal/client/external"
+	"medods/internal/domain/audit"
+	key2 "medods/internal/domain/key"
 	request "medods/internal/domain/request"
 	"medods/internal/domain/token"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/repository"
 	"medods/internal/repository/blacklist"
 	token2 "medods/internal/repository/token"
+	"medods/internal/service/key"
+	"strings"
 	"time"
 )
 
 const (
-	Issuer string = "medods_test"
-	JwtId  string = "JWTID"
+	// legacyJwtId jti shared by all access tokens issued before jti became unique
+	legacyJwtId = "JWTID"
+
+	refreshTokenSeparator = "."
+	selectorLen           = 16
+	verifierLen           = 32
+)
+
+var (
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected")
+	ErrRefreshTokenExpired = errors.New("refresh token expired")
+	// ErrRefreshConflict returned to loser of concurrent refreshes of the same token
+	ErrRefreshConflict   = errors.New("refresh token was used by concurrent request")
+	ErrUserAgentMismatch = errors.New("user agent mismatch")
 )
 
 type TokenService struct {
-	c      *external.ExternalServiceClient
-	blRepo *blacklist.BlacklistRepository
-	tRepo  *token2.TokenRepository
+	conf   *TokenConfig
+	outbox Outbox
+	blRepo BlacklistRepository
+	tRepo  TokenRepository
+	keys   *key.KeyRing
+	uow    repository.Transactor
+	// revoked optional cache of blacklist, nil means database is always used
+	revoked RevocationCache
+	audit   AuditLogger
 }
 
-func NewTService(c *external.ExternalServiceClient, blRepo *blacklist.BlacklistRepository, tRepo *token2.TokenRepository) *TokenService {
-	return &TokenService{c: c, blRepo: blRepo, tRepo: tRepo}
+func NewTService(conf *TokenConfig, outbox Outbox, blRepo BlacklistRepository, tRepo TokenRepository, keys *key.KeyRing, uow repository.Transactor, revoked RevocationCache, audit AuditLogger) *TokenService {
+	return &TokenService{conf: conf, outbox: outbox, blRepo: blRepo, tRepo: tRepo, keys: keys, uow: uow, revoked: revoked, audit: audit}
 }
 
-var alg = jwt.NewHS512([]byte("secret_phrase"))
-
-// build access token
-func buildAccessToken(userGuid string, key string) (string, error) {
+// build access token signed by current active key of key ring
+func (ts *TokenService) buildAccessToken(userGuid string, key string, clientID string, sessionID string) (string, error) {
 	timeNow := time.Now()
 
+	kid, alg, err := ts.keys.Signer()
+	if err != nil {
+		return "", fmt.Errorf("get signing key: %w", err)
+	}
+
 	pl := token.AccessTokenPayload{
 		Payload: jwt.Payload{
-			Issuer:         Issuer,
+			Issuer:         ts.conf.Issuer,
 			Subject:        userGuid,
-			Audience:       jwt.Audience{"localhost:8080"},
-			ExpirationTime: jwt.NumericDate(timeNow.Add(24 * time.Hour)),
+			Audience:       jwt.Audience{ts.conf.Audience},
+			ExpirationTime: jwt.NumericDate(timeNow.Add(ts.conf.AccessTokenTTL)),
 			NotBefore:      jwt.NumericDate(timeNow.Add(30 * time.Minute)),
 			IssuedAt:       jwt.NumericDate(timeNow),
-			JWTID:          JwtId,
+			JWTID:          uuid.NewString(),
 		},
-		Key: key,
+		Key:       key,
+		ClientID:  clientID,
+		SessionID: sessionID,
 	}
-	//build jwt token by alg SHA512
-	buildedToken, err := jwt.Sign(pl, alg)
+	//build jwt token, kid header points to key for verification
+	buildedToken, err := jwt.Sign(pl, alg, jwt.KeyID(kid))
 	if err != nil {
 		return "", fmt.Errorf("jwt sign err %w", err)
 	}
@@ -62,56 +89,91 @@ func buildAccessToken(userGuid string, key string) (string, error) {
 	return string(buildedToken), nil
 }
 
-// build refresh token
-func buildRefreshToken(key string) (refresh string, err error) {
-	//build payload
-	pl := token.RefreshTokenPayload{Time: time.Now().String(), Key: key}
-
-	//marshal payload to bytes
-	plBytes, err := json.Marshal(pl)
-	if err != nil {
-		return "", err
+// build refresh token in format "selector.verifier". Selector is stored as is and used
+// for lookup of token in database, only hash of verifier is stored
+func buildRefreshToken() (refresh string, selector string, verifierHash string, err error) {
+	selectorBytes := make([]byte, selectorLen)
+	if _, err = rand.Read(selectorBytes); err != nil {
+		return "", "", "", fmt.Errorf("generate selector: %w", err)
+	}
+	verifierBytes := make([]byte, verifierLen)
+	if _, err = rand.Read(verifierBytes); err != nil {
+		return "", "", "", fmt.Errorf("generate verifier: %w", err)
 	}
 
-	//calc len of slice base64 encoded payload
-	encodedLen := base64.RawURLEncoding.EncodedLen(len(plBytes))
-
-	refreshBytes := make([]byte, encodedLen)
-
-	// encode payload to base64
-	base64.RawURLEncoding.Encode(refreshBytes, plBytes)
+	selector = base64.RawURLEncoding.EncodeToString(selectorBytes)
+	verifier := base64.RawURLEncoding.EncodeToString(verifierBytes)
 
-	refresh = string(refreshBytes)
+	refresh = selector + refreshTokenSeparator + verifier
+	verifierHash = hashVerifier(verifier)
 	return
 }
 
+// split refresh token to selector and verifier
+func parseRefreshToken(refresh string) (selector string, verifier string, err error) {
+	selector, verifier, ok := strings.Cut(refresh, refreshTokenSeparator)
+	if !ok || selector == "" || verifier == "" {
+		return "", "", errors.New("malformed refresh token")
+	}
+	return selector, verifier, nil
+}
+
 func (ts *TokenService) Refresh(ctx context.Context, tokenPayload *token.TokensPair, data request.RequestData) (err error) {
+	//every refresh is audited, user and session are filled as soon as they are known
+	ev := audit.Event{Type: audit.EventRefresh, IP: data.IP, UserAgent: data.UserAgent}
+	defer func() { ts.auditRefresh(ctx, ev, err) }()
+
 	//convert access token to bytes and verify it
 	tokenBytes := []byte(tokenPayload.AccessToken)
 	var tokenPl token.AccessTokenPayload
-	_, err = jwt.Verify(tokenBytes, alg, &tokenPl)
+	_, err = jwt.Verify(tokenBytes, ts.keys.Resolver(), &tokenPl, jwt.ValidateHeader)
 	if err != nil {
 		return fmt.Errorf("verify token error: %w", err)
 	}
+	ev.UserGuid, ev.SessionID = tokenPl.Subject, tokenPl.SessionID
 
-	//decode refresh token from base64 string to payload struct
-	var refreshTokenPl token.RefreshTokenPayload
-	err = decodeRefreshToken(&refreshTokenPl, tokenPayload.RefreshToken)
+	//split refresh token to selector for lookup and verifier
+	selector, verifier, err := parseRefreshToken(tokenPayload.RefreshToken)
 	if err != nil {
-		return fmt.Errorf("decode refresh token pl error: %w", err)
+		return fmt.Errorf("parse refresh token error: %w", err)
+	}
+
+	var access, refresh string
+
+	// get refresh token from database by selector, inactive tokens are needed for reuse detection
+	validRefresh, err := ts.tRepo.GetOne(ctx, repository.Where(repository.Eq(token2.SelectorField, selector)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+		return errors.New("refresh token is not valid")
+	case err != nil:
+		return fmt.Errorf("get refresh token from db error: %w", err)
 	}
 
-	// compare keys of refresh and access tokens
-	if refreshTokenPl.Key != tokenPl.Key {
-		return fmt.Errorf("invalid token")
+	// refresh token must belong to the same pair and session as access token
+	if !compareVerifier(verifier, validRefresh.RefreshToken) || validRefresh.TokenKey != tokenPl.Key ||
+		validRefresh.UserGuid != tokenPl.Subject || validRefresh.FamilyID != tokenPl.SessionID {
+		return errors.New("refresh token is not valid")
 	}
 
-	var access, refresh string
+	ev.SessionID = validRefresh.FamilyID
 
-	// get old active refresh tokens from database by user guid
-	oldTokens, err := ts.tRepo.GetManyBy(ctx, []token2.FieldName{token2.UserGuidField, token2.ActiveField}, []interface{}{tokenPl.Subject, true})
+	if !validRefresh.Active {
+		return ts.checkReuse(ctx, validRefresh, data)
+	}
+
+	//new refresh token expires after idle timeout, but not later than session lifetime
+	timeNow := time.Now()
+	sessionStart, err := ts.sessionStart(ctx, validRefresh)
 	if err != nil {
-		return fmt.Errorf("get old active tokens from db error: %w", err)
+		return fmt.Errorf("get start of session error: %w", err)
+	}
+	expiresAt := ts.refreshExpiresAt(timeNow, sessionStart)
+	if !timeNow.Before(validRefresh.ExpiresAt) || !timeNow.Before(expiresAt) {
+		err = ts.tRepo.Deactivate(ctx, repository.Eq(token2.IdField, validRefresh.ID))
+		if err != nil {
+			return fmt.Errorf("deactivate expired refresh token error: %w", err)
+		}
+		return ErrRefreshTokenExpired
 	}
 
 	// generate uuid for unique key of payload
@@ -120,78 +182,101 @@ func (ts *TokenService) Refresh(ctx context.Context, tokenPayload *token.TokensP
 		return fmt.Errorf("generate uuid for payload error: %w", err)
 	}
 
-	// validate refresh token from user
-	validRefresh, err := findActiveRefreshTokenFromDBByRefreshToken(oldTokens, tokenPayload.RefreshToken)
-	if err != nil {
-		return fmt.Errorf("validate users refresh token error: %w", err)
-	}
-
 	//compare user-agent
 	if validRefresh.UserAgent != data.UserAgent {
-		err = ts.BlockToken(ctx, tokenPayload.AccessToken)
-		return fmt.Errorf("user agent mismatch")
-	}
-
-	//compare ip
-	if validRefresh.IP != data.IP {
-
-		//send webhook about changes of ip address
-		err = ts.c.SendWebhook("dummy_url", map[string]string{"warn": fmt.Sprintf("changed id from %s to %s", validRefresh.IP, data.IP)})
-		if err != nil {
-			log.Printf("error while sending webhook: %s", err)
+		if _, err = ts.blockToken(ctx, tokenPayload.AccessToken, webhook2.RevokeReasonUserAgentMismatch); err != nil {
+			return fmt.Errorf("%w: block token: %w", ErrUserAgentMismatch, err)
 		}
+		return ErrUserAgentMismatch
 	}
 
 	//build access token
-	access, err = buildAccessToken(validRefresh.UserGuid, genUuid.String())
+	access, err = ts.buildAccessToken(validRefresh.UserGuid, genUuid.String(), tokenPl.ClientID, validRefresh.FamilyID)
 	if err != nil {
 		return fmt.Errorf("build access token error: %w", err)
 	}
 
 	//build refresh token
-	refresh, err = buildRefreshToken(genUuid.String())
+	refresh, newSelector, verifierHash, err := buildRefreshToken()
 	if err != nil {
 		return fmt.Errorf("build refresh token: %w", err)
 	}
 
-	//process refresh token for save to database
-	bcrypted, txErr := processForStorageInDatabase(refresh)
-	if txErr != nil {
-		return fmt.Errorf("proccess token to database format error: %w", txErr)
-	}
-
-	// deactivate old refresh token
-	validRefresh.Active = false
-	err = ts.tRepo.Update(ctx, validRefresh, []token2.FieldName{token2.IdField}, []interface{}{validRefresh.ID})
-	if err != nil {
-		return fmt.Errorf("deactivate old refresh token error: %w", txErr)
-	}
-
-	// save new refresh token to database
-	created := &token2.Token{RefreshToken: bcrypted, UserGuid: validRefresh.UserGuid, Active: true, UserAgent: data.UserAgent, IP: data.IP}
-	err = ts.tRepo.Create(ctx, created)
-	if err != nil {
-		return fmt.Errorf("save new refresh token error: %w", txErr)
+	// new refresh token is child of old one
+	created := &token2.Token{
+		RefreshToken: verifierHash,
+		UserGuid:     validRefresh.UserGuid,
+		Active:       true,
+		UserAgent:    data.UserAgent,
+		IP:           data.IP,
+		TokenKey:     genUuid.String(),
+		FamilyID:     validRefresh.FamilyID,
+		ParentID:     sql.NullInt64{Int64: validRefresh.ID, Valid: true},
+		Generation:   validRefresh.Generation + 1,
+		Selector:     newSelector,
+		ExpiresAt:    expiresAt,
+		ClientID:     validRefresh.ClientID,
+	}
+	// deactivate old refresh token and save new one atomically, so only one concurrent refresh wins.
+	// Webhook about change of ip address is stored in the same transaction
+	err = ts.uow.Do(ctx, func(ctx context.Context) error {
+		if err := ts.tRepo.Rotate(ctx, validRefresh, created); err != nil {
+			return err
+		}
+		if validRefresh.IP == data.IP {
+			return nil
+		}
+		return ts.outbox.Publish(ctx, webhook2.EventIPChanged, webhook2.IPChanged{
+			UserGuid:   validRefresh.UserGuid,
+			SessionID:  validRefresh.FamilyID,
+			PreviousIP: validRefresh.IP,
+			IP:         data.IP,
+			UserAgent:  data.UserAgent,
+		})
+	})
+	//under repeatable read and serializable isolation loser of concurrent rotation gets serialization failure
+	switch {
+	case err != nil && (errors.Is(err, token2.ErrNotActive) || repository.IsSerializationFailure(err)):
+		return ErrRefreshConflict
+	case err != nil:
+		return fmt.Errorf("rotate refresh token error: %w", err)
 	}
 
 	tokenPayload.AccessToken = access
 	tokenPayload.RefreshToken = refresh
+	tokenPayload.ExpiresIn = int(ts.conf.AccessTokenTTL.Seconds())
+	tokenPayload.RefreshExpiresIn = int(expiresAt.Sub(timeNow).Seconds())
+	tokenPayload.SessionID = validRefresh.FamilyID
 	return err
 }
 
-// convert token to sha256 and crypt by bcrypt alg
-func processForStorageInDatabase(token string) (string, error) {
-	refreshBytes := []byte(token)
-	sh := sha256.Sum256(refreshBytes)
-	hashed, err := bcrypt.GenerateFromPassword(sh[:], bcrypt.DefaultCost)
-	return string(hashed), err
+// auditRefresh record outcome of refresh, failures of security checks are recorded as events of their own type
+func (ts *TokenService) auditRefresh(ctx context.Context, e audit.Event, err error) {
+	e.Outcome = audit.OutcomeSuccess
+	if err != nil {
+		e.Outcome, e.Reason = audit.OutcomeFailure, err.Error()
+		switch {
+		case errors.Is(err, ErrUserAgentMismatch):
+			e.Type = audit.EventUserAgentMismatch
+		case errors.Is(err, ErrRefreshTokenReused):
+			e.Type = audit.EventTokenReuse
+		}
+	}
+	ts.audit.Log(ctx, e)
+}
+
+// convert verifier of refresh token to format stored in database. Verifier is random
+// so sha256 is enough and there is no need of slow hash
+func hashVerifier(verifier string) string {
+	sh := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sh[:])
 }
 
 // Valid validate access token
 func (ts *TokenService) Valid(accessToken string, dst *token.AccessTokenPayload) error {
 	// try to verify jwt token
 	tokenBytes := []byte(accessToken)
-	_, err := jwt.Verify(tokenBytes, alg, &dst)
+	_, err := jwt.Verify(tokenBytes, ts.keys.Resolver(), &dst, jwt.ValidateHeader)
 	if err != nil {
 		return err
 	}
@@ -204,14 +289,7 @@ func (ts *TokenService) Valid(accessToken string, dst *token.AccessTokenPayload)
 	return nil
 }
 
-func decodeRefreshToken(pl *token.RefreshTokenPayload, refresh string) error {
-	decoded, err := base64.RawURLEncoding.DecodeString(refresh)
-	if err != nil {
-		return err
-	}
-	return json.Unmarshal(decoded, pl)
-}
-
+// Build create new session of user and return its token pair
 func (ts *TokenService) Build(ctx context.Context, id string, data request.RequestData) (token.TokensPair, error) {
 	var refresh string
 
@@ -220,118 +298,282 @@ func (ts *TokenService) Build(ctx context.Context, id string, data request.Reque
 	if err != nil {
 		return token.TokensPair{}, fmt.Errorf("generate uuid for token payload: %w", err)
 	}
+	sessionID := uuid.NewString()
 
 	//build access token
-	access, err := buildAccessToken(id, genUuid.String())
+	access, err := ts.buildAccessToken(id, genUuid.String(), data.ClientID, sessionID)
 	if err != nil {
 		return token.TokensPair{}, fmt.Errorf("build access token error: %w", err)
 	}
 
 	//build refresh token
-	refreshResult, err := buildRefreshToken(genUuid.String())
+	refreshResult, selector, verifierHash, err := buildRefreshToken()
 	if err != nil {
 		return token.TokensPair{}, fmt.Errorf("build refresh token error: %w", err)
 	}
 
-	//process refresh token to database format
-	bcryptedPass, err := processForStorageInDatabase(refreshResult)
-	if err != nil {
-		return token.TokensPair{}, fmt.Errorf("proccess token to database format error: %w", err)
-	}
-
-	//find exists active tokens
-	oldToken, err := ts.tRepo.GetOneBy(ctx, []token2.FieldName{token2.UserGuidField, token2.ActiveField}, []interface{}{id, true})
-
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-
-		} else {
-			return token.TokensPair{}, fmt.Errorf("get old token: %w", err)
+	//create new refresh token, it starts new session
+	timeNow := time.Now()
+	expiresAt := ts.refreshExpiresAt(timeNow, timeNow)
+	refreshToken := &token2.Token{
+		UserGuid:     id,
+		RefreshToken: verifierHash,
+		Active:       true,
+		IP:           data.IP,
+		UserAgent:    data.UserAgent,
+		TokenKey:     genUuid.String(),
+		FamilyID:     sessionID,
+		Selector:     selector,
+		ExpiresAt:    expiresAt,
+		ClientID:     data.ClientID,
+	}
+
+	//create new token, oldest sessions are evicted if user has too many
+	err = ts.uow.Do(ctx, func(ctx context.Context) error {
+		evicted, err := ts.tRepo.CreateSession(ctx, refreshToken, ts.conf.MaxSessions)
+		if err != nil {
+			return err
 		}
-	} else {
-		oldToken.Active = false
-		if err = ts.tRepo.Update(ctx, oldToken, []token2.FieldName{token2.IdField}, []interface{}{oldToken.ID}); err != nil {
-			return token.TokensPair{}, fmt.Errorf("deactivate old token: %w", err)
+		for _, v := range evicted {
+			if err = ts.revokeFamily(ctx, v, webhook2.RevokeReasonEvicted); err != nil {
+				return fmt.Errorf("revoke evicted session %s: %w", v, err)
+			}
 		}
-	}
-
-	//create new refresh token
-	refreshToken := &token2.Token{UserGuid: id, RefreshToken: bcryptedPass, Active: true, IP: data.IP, UserAgent: data.UserAgent}
-
-	err = ts.tRepo.Create(ctx, refreshToken) //create new token
+		return ts.outbox.Publish(ctx, webhook2.EventSessionCreated, webhook2.SessionCreated{
+			UserGuid:  id,
+			SessionID: sessionID,
+			IP:        data.IP,
+			UserAgent: data.UserAgent,
+		})
+	})
 	if err != nil {
 		return token.TokensPair{}, err
 	}
 
 	refresh = refreshResult
 
-	return token.TokensPair{AccessToken: access, RefreshToken: refresh}, nil
+	return token.TokensPair{
+		AccessToken:      access,
+		RefreshToken:     refresh,
+		ExpiresIn:        int(ts.conf.AccessTokenTTL.Seconds()),
+		RefreshExpiresIn: int(expiresAt.Sub(timeNow).Seconds()),
+		SessionID:        sessionID,
+	}, nil
 }
 
-func compareUserTokenAndDBToken(userToken string, dbToken string) bool {
-	shaHash := sha256.Sum256([]byte(userToken))
-	err := bcrypt.CompareHashAndPassword([]byte(dbToken), shaHash[:])
-	return err == nil
+// compare verifier of user token with hash from database in constant time
+func compareVerifier(verifier string, dbHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashVerifier(verifier)), []byte(dbHash)) == 1
 }
 
+// BlockToken block access token and revoke its session on logout
 func (ts *TokenService) BlockToken(ctx context.Context, accessToken string) error {
-	//block token
-	err := ts.blRepo.Create(ctx, &blacklist.Blacklist{AccessToken: accessToken})
+	pl, err := ts.blockToken(ctx, accessToken, webhook2.RevokeReasonLogout)
+	e := audit.Event{Type: audit.EventLogout, Outcome: audit.OutcomeSuccess}
+	if pl != nil {
+		e.UserGuid, e.SessionID = pl.Subject, pl.SessionID
+	}
 	if err != nil {
-		return fmt.Errorf("block token: %w", err)
+		e.Outcome, e.Reason = audit.OutcomeFailure, err.Error()
 	}
+	ts.audit.Log(ctx, e)
+	return err
+}
 
+// blockToken block access token and revoke its session for reason, payload is returned once token is verified
+func (ts *TokenService) blockToken(ctx context.Context, accessToken string, reason string) (*token.AccessTokenPayload, error) {
 	//verify access token and store to struct
 	tokenBytes := []byte(accessToken)
 	var tokenPl token.AccessTokenPayload
-	_, err = jwt.Verify(tokenBytes, alg, &tokenPl)
+	_, err := jwt.Verify(tokenBytes, ts.keys.Resolver(), &tokenPl, jwt.ValidateHeader)
 	if err != nil {
-		return fmt.Errorf("verify token: %w", err)
+		return nil, fmt.Errorf("verify token: %w", err)
 	}
 
-	//find old refresh token
-	oldToken, err := ts.tRepo.GetOneBy(ctx,
-		[]token2.FieldName{token2.UserGuidField, token2.ActiveField},
-		[]interface{}{tokenPl.Subject, true})
+	//block token and deactivate its refresh token together
+	entry := ts.blacklistEntry(accessToken, &tokenPl)
+	err = ts.uow.Do(ctx, func(ctx context.Context) error {
+		err := ts.blRepo.Create(ctx, entry)
+		if err != nil {
+			return fmt.Errorf("block token: %w", err)
+		}
+
+		//deactivate refresh token of session only, tokens issued before sessions are found by key
+		if tokenPl.SessionID == "" {
+			err = ts.tRepo.Deactivate(ctx, repository.Eq(token2.TokenKeyField, tokenPl.Key), repository.Eq(token2.ActiveField, true))
+			if err != nil {
+				return fmt.Errorf("deactivate token: %w", err)
+			}
+			return nil
+		}
+		if err = ts.tRepo.DeactivateFamily(ctx, tokenPl.SessionID); err != nil {
+			return fmt.Errorf("deactivate token: %w", err)
+		}
+		return ts.outbox.Publish(ctx, webhook2.EventSessionRevoked, webhook2.SessionRevoked{
+			UserGuid:  tokenPl.Subject,
+			SessionID: tokenPl.SessionID,
+			Reason:    reason,
+		})
+	})
 	if err != nil {
-		return fmt.Errorf("get old token: %w", err)
+		return &tokenPl, err
 	}
+	ts.cacheRevoked(ctx, *entry)
+	return &tokenPl, nil
+}
 
-	//deactivate old refresh token
-	oldToken.Active = false
-	err = ts.tRepo.Update(ctx, oldToken, []token2.FieldName{token2.IdField}, []interface{}{oldToken.ID})
-	if err != nil {
-		return fmt.Errorf("deactivate token: %w", err)
+// blacklistEntry build blacklist entry of verified access token, entry is kept until token expires
+func (ts *TokenService) blacklistEntry(accessToken string, pl *token.AccessTokenPayload) *blacklist.Blacklist {
+	entry := &blacklist.Blacklist{JTI: pl.JWTID, ExpiresAt: time.Now().Add(ts.conf.AccessTokenTTL)}
+	if pl.ExpirationTime != nil {
+		entry.ExpiresAt = pl.ExpirationTime.Time
 	}
-	return nil
+	//tokens without unique jti are blocked by themselves
+	if pl.JWTID == "" || pl.JWTID == legacyJwtId {
+		entry.JTI, entry.AccessToken = "", accessToken
+	}
+	return entry
+}
+
+// cacheRevoked put entries to revocation cache right after they are committed,
+// so this replica doesn't wait for notification about them. Entries of rolled back transaction aren't cached
+func (ts *TokenService) cacheRevoked(ctx context.Context, entries ...blacklist.Blacklist) {
+	if ts.revoked == nil || len(entries) == 0 {
+		return
+	}
+	repository.AfterCommit(ctx, func() {
+		ts.revoked.Add(entries...)
+	})
+}
+
+// JWKS return public keys for verification of access tokens
+func (ts *TokenService) JWKS() []key2.JWK {
+	return ts.keys.JWKS()
 }
 
 func (ts *TokenService) VerifyToken(ctx context.Context, accessToken string) bool {
-	// find token in black list
-	_, err := ts.blRepo.GetOneBy(ctx, []blacklist.FieldName{blacklist.AccessTokenFieldName}, []interface{}{accessToken})
+	var tokenPl token.AccessTokenPayload
+	if err := ts.Valid(accessToken, &tokenPl); err != nil {
+		return false
+	}
+
+	// find token in black list by its jti and by key of token pair,
+	// tokens without unique jti are found by token itself
+	lookups := []struct {
+		field blacklist.FieldName
+		value string
+	}{
+		{blacklist.JTIFieldName, tokenPl.JWTID},
+		{blacklist.TokenKeyFieldName, tokenPl.Key},
+	}
+	if tokenPl.JWTID == "" || tokenPl.JWTID == legacyJwtId {
+		lookups[0].field, lookups[0].value = blacklist.AccessTokenFieldName, accessToken
+	}
+	for _, l := range lookups {
+		if l.value == "" {
+			continue
+		}
+		if ts.revoked != nil {
+			if revoked, ok := ts.revoked.Revoked(l.field, l.value); ok {
+				if revoked {
+					return false
+				}
+				continue
+			}
+		}
+		_, err := ts.blRepo.GetOne(ctx, repository.Where(repository.Eq(l.field, l.value)))
+
+		//handle error
+		switch {
+		case err != nil && errors.Is(err, sql.ErrNoRows):
+			continue // token not found
+		case err != nil:
+			return false // error
+		}
+		return false
+	}
+	return true
+}
 
-	//handle error
+// checkReuse handle presented inactive refresh token. If it was rotated then it is replayed:
+// whole family is revoked, its access tokens are blocked and security event is sent.
+// Token rotated within grace period is refused as used by concurrent refresh, so loser of race
+// which came just after commit of winner doesn't revoke its session
+func (ts *TokenService) checkReuse(ctx context.Context, presented *token2.Token, data request.RequestData) error {
+	child, err := ts.tRepo.GetOne(ctx, repository.Where(repository.Eq(token2.ParentIdField, presented.ID)))
 	switch {
 	case err != nil && errors.Is(err, sql.ErrNoRows):
-		return true // token not found
+		// token was revoked, not rotated
+		return errors.New("refresh token is not valid")
 	case err != nil:
-		return false // error
+		return fmt.Errorf("get child refresh token error: %w", err)
+	}
+	if time.Since(child.CreatedAt) < ts.conf.ReuseGracePeriod {
+		return ErrRefreshConflict
 	}
-	return false
-}
 
-func findActiveRefreshTokenFromDBByRefreshToken(tokens []token2.Token, userRefreshToken string) (*token2.Token, error) {
-	var validRefresh *token2.Token
-	//compare bcrypt user token and bcrypt tokens from database
-	for _, v := range tokens {
-		if compareUserTokenAndDBToken(userRefreshToken, v.RefreshToken) {
-			validRefresh = &v
-			break
+	//family is revoked together with storing of webhook about it
+	err = ts.uow.Do(ctx, func(ctx context.Context) error {
+		if err := ts.revokeFamily(ctx, presented.FamilyID, webhook2.RevokeReasonReuseDetected); err != nil {
+			return fmt.Errorf("revoke token family error: %w", err)
 		}
+		return ts.outbox.Publish(ctx, webhook2.EventTokenReuseDetected, webhook2.TokenReuseDetected{
+			UserGuid:   presented.UserGuid,
+			SessionID:  presented.FamilyID,
+			Generation: presented.Generation,
+			IP:         data.IP,
+			UserAgent:  data.UserAgent,
+		})
+	})
+	if err != nil {
+		return err
 	}
-	//if token not found in database then it not valid
-	if validRefresh == nil {
-		return nil, errors.New("refresh token is not valid")
+	return ErrRefreshTokenReused
+}
+
+// revokeFamily deactivate all refresh tokens of family, block access tokens issued with them
+// and publish revocation of session for reason
+func (ts *TokenService) revokeFamily(ctx context.Context, familyID string, reason string) error {
+	blocked := make([]blacklist.Blacklist, 0)
+	err := ts.uow.Do(ctx, func(ctx context.Context) error {
+		//deactivate first, so tokens created by concurrent rotation are blocked too
+		if err := ts.tRepo.DeactivateFamily(ctx, familyID); err != nil {
+			return fmt.Errorf("deactivate token family: %w", err)
+		}
+		family, err := ts.tRepo.GetMany(ctx, repository.Where(repository.Eq(token2.FamilyIdField, familyID)))
+		if err != nil {
+			return fmt.Errorf("get token family: %w", err)
+		}
+		for _, v := range family {
+			if v.TokenKey == "" {
+				continue
+			}
+			//access tokens of pair were issued before now, so they expire before now + ttl
+			entry := &blacklist.Blacklist{TokenKey: v.TokenKey, ExpiresAt: time.Now().Add(ts.conf.AccessTokenTTL)}
+			if err = ts.blRepo.Create(ctx, entry); err != nil {
+				return fmt.Errorf("block access token: %w", err)
+			}
+			blocked = append(blocked, *entry)
+		}
+		if len(family) == 0 {
+			return nil
+		}
+		ts.audit.Log(ctx, audit.Event{
+			Type:      audit.EventSessionRevoked,
+			Outcome:   audit.OutcomeSuccess,
+			UserGuid:  family[0].UserGuid,
+			SessionID: familyID,
+			Reason:    reason,
+		})
+		return ts.outbox.Publish(ctx, webhook2.EventSessionRevoked, webhook2.SessionRevoked{
+			UserGuid:  family[0].UserGuid,
+			SessionID: familyID,
+			Reason:    reason,
+		})
+	})
+	if err != nil {
+		return err
 	}
-	return validRefresh, nil
+	ts.cacheRevoked(ctx, blocked...)
+	return nil
 }
@@ -0,0 +1,111 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"medods/internal/domain/audit"
+	"medods/internal/domain/token"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/repository"
+	token2 "medods/internal/repository/token"
+	"time"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// Sessions return active sessions of user
+func (ts *TokenService) Sessions(ctx context.Context, userGuid string) ([]token.Session, error) {
+	stored, err := ts.tRepo.GetSessions(ctx, userGuid)
+	if err != nil {
+		return nil, fmt.Errorf("get sessions: %w", err)
+	}
+	sessions := make([]token.Session, len(stored))
+	for i, v := range stored {
+		sessions[i] = token.Session{
+			ID:              v.ID,
+			CreatedAt:       v.CreatedAt,
+			LastRefreshedAt: v.LastRefreshedAt,
+			IP:              v.IP,
+			UserAgent:       v.UserAgent,
+		}
+	}
+	return sessions, nil
+}
+
+// RevokeSession revoke active session of user, failed revocation is audited here and successful one by revokeFamily
+func (ts *TokenService) RevokeSession(ctx context.Context, userGuid string, sessionID string) (err error) {
+	defer func() {
+		if err != nil {
+			ts.audit.Log(ctx, audit.Event{
+				Type:      audit.EventSessionRevoked,
+				Outcome:   audit.OutcomeFailure,
+				UserGuid:  userGuid,
+				SessionID: sessionID,
+				Reason:    err.Error(),
+			})
+		}
+	}()
+
+	_, err = ts.tRepo.GetOne(ctx, repository.Where(
+		repository.Eq(token2.FamilyIdField, sessionID),
+		repository.Eq(token2.UserGuidField, userGuid),
+		repository.Eq(token2.ActiveField, true)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+		return ErrSessionNotFound
+	case err != nil:
+		return fmt.Errorf("get session: %w", err)
+	}
+	return ts.revokeFamily(ctx, sessionID, webhook2.RevokeReasonRevoked)
+}
+
+// RevokeAllSessions revoke all active sessions of user in one transaction, so either all of them are revoked or none
+func (ts *TokenService) RevokeAllSessions(ctx context.Context, userGuid string) error {
+	return ts.uow.Do(ctx, func(ctx context.Context) error {
+		active, err := ts.tRepo.GetMany(ctx, repository.Where(
+			repository.Eq(token2.UserGuidField, userGuid),
+			repository.Eq(token2.ActiveField, true),
+			repository.Gt(token2.ExpiresAtField, time.Now())))
+		if err != nil {
+			return fmt.Errorf("get active tokens: %w", err)
+		}
+		for _, v := range active {
+			if err = ts.revokeFamily(ctx, v.FamilyID, webhook2.RevokeReasonRevoked); err != nil {
+				return fmt.Errorf("revoke session %s: %w", v.FamilyID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// PurgeExpired remove sessions expired before now and return number of removed refresh tokens
+func (ts *TokenService) PurgeExpired(ctx context.Context) (int64, error) {
+	n, err := ts.tRepo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("purge expired tokens: %w", err)
+	}
+	return n, nil
+}
+
+// sessionStart return creation time of first refresh token of family
+func (ts *TokenService) sessionStart(ctx context.Context, t *token2.Token) (time.Time, error) {
+	if t.Generation == 0 {
+		return t.CreatedAt, nil
+	}
+	root, err := ts.tRepo.GetOne(ctx, repository.Where(repository.Eq(token2.FamilyIdField, t.FamilyID), repository.Eq(token2.GenerationField, 0)))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get first token of session: %w", err)
+	}
+	return root.CreatedAt, nil
+}
+
+// refreshExpiresAt return expiration time of refresh token issued at now in session started at sessionStart
+func (ts *TokenService) refreshExpiresAt(now time.Time, sessionStart time.Time) time.Time {
+	expiresAt := now.Add(ts.conf.RefreshIdleTimeout)
+	if deadline := sessionStart.Add(ts.conf.SessionMaxLifetime); deadline.Before(expiresAt) {
+		return deadline
+	}
+	return expiresAt
+}
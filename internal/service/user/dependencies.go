@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+	"medods/internal/domain/audit"
+	"medods/internal/repository"
+	"medods/internal/repository/user"
+)
+
+// UserRepository storage of users, implemented by *user.UserRepository and in-memory repository
+type UserRepository interface {
+	Create(ctx context.Context, model *user.User) error
+	GetOne(ctx context.Context, q repository.Query[user.FieldName]) (*user.User, error)
+}
+
+// AuditLogger append-only log of security events
+type AuditLogger interface {
+	Log(ctx context.Context, e audit.Event)
+}
@@ -5,33 +5,54 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/Microsoft/go-winio/pkg/guid"
+	"medods/internal/domain/audit"
 	request2 "medods/internal/domain/request"
 	token2 "medods/internal/domain/token"
+	"medods/internal/repository"
 	"medods/internal/repository/user"
 	"medods/internal/service/token"
 )
 
 type UserService struct {
-	userRepo     *user.UserRepository
+	userRepo     UserRepository
 	tokenService *token.TokenService
+	uow          repository.Transactor
+	audit        AuditLogger
 }
 
-func NewUService(uRepo *user.UserRepository, ts *token.TokenService) *UserService {
-	return &UserService{tokenService: ts, userRepo: uRepo}
+func NewUService(uRepo UserRepository, ts *token.TokenService, uow repository.Transactor, audit AuditLogger) *UserService {
+	return &UserService{tokenService: ts, userRepo: uRepo, uow: uow, audit: audit}
 }
 
-func (us *UserService) Authorize(ctx context.Context, id string, data request2.RequestData) (token2.TokensPair, error) {
-	_, err := guid.FromString(id)
+func (us *UserService) Authorize(ctx context.Context, id string, data request2.RequestData) (pair token2.TokensPair, err error) {
+	defer func() {
+		e := audit.Event{Type: audit.EventAuthorize, Outcome: audit.OutcomeSuccess, UserGuid: id,
+			SessionID: pair.SessionID, IP: data.IP, UserAgent: data.UserAgent}
+		if err != nil {
+			e.Outcome, e.Reason = audit.OutcomeFailure, err.Error()
+		}
+		us.audit.Log(ctx, e)
+	}()
+
+	_, err = guid.FromString(id)
 	if err != nil {
 		return token2.TokensPair{}, err
 	}
-	u, err := us.userRepo.GetOneBy(ctx, []user.FieldName{user.GuidFieldName}, []interface{}{id})
-	switch {
-	case err != nil && errors.Is(err, sql.ErrNoRows):
-		u = &user.User{Guid: id}
-		err = us.userRepo.Create(ctx, u)
-	case err != nil:
-		return token2.TokensPair{}, err
-	}
-	return us.tokenService.Build(ctx, id, data)
+
+	//user is created only together with its first session
+	err = us.uow.Do(ctx, func(ctx context.Context) error {
+		u, err := us.userRepo.GetOne(ctx, repository.Where(repository.Eq(user.GuidFieldName, id)))
+		switch {
+		case err != nil && errors.Is(err, sql.ErrNoRows):
+			u = &user.User{Guid: id}
+			if err = us.userRepo.Create(ctx, u); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		}
+		pair, err = us.tokenService.Build(ctx, id, data)
+		return err
+	})
+	return pair, err
 }
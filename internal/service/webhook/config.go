@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"fmt"
+	"go.dataddo.com/env"
+	"log"
+	"time"
+)
+
+const (
+	defaultPollInterval   = time.Second
+	defaultBatchSize      = 20
+	defaultLease          = 5 * time.Minute
+	defaultMaxAttempts    = 10
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Hour
+	defaultTimeout        = 10 * time.Second
+)
+
+type Config struct {
+	// PollInterval how often outbox is checked for due events
+	PollInterval time.Duration `env:"POLL_INTERVAL"`
+	BatchSize    int           `env:"BATCH_SIZE"`
+	// Lease time for which claimed events are hidden from other dispatchers, it must cover delivery of batch
+	Lease time.Duration `env:"LEASE"`
+	// MaxAttempts number of failed attempts after which event is dead-lettered
+	MaxAttempts int `env:"MAX_ATTEMPTS"`
+	// InitialBackoff delay after first failed attempt, it is doubled after every next one up to MaxBackoff
+	InitialBackoff time.Duration `env:"INITIAL_BACKOFF"`
+	MaxBackoff     time.Duration `env:"MAX_BACKOFF"`
+	// Timeout limit of one attempt of delivery
+	Timeout time.Duration `env:"TIMEOUT"`
+	// AllowInsecureTargets allow plain http receivers and receivers on loopback, private and link-local addresses,
+	// it is meant for local development only
+	AllowInsecureTargets bool `env:"ALLOW_INSECURE_TARGETS"`
+}
+
+func NewConfig() (*Config, error) {
+	var config Config
+	if err := env.Load(&config, "WEBHOOK_"); err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the webhook config: %v", err)
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	if config.Lease == 0 {
+		config.Lease = defaultLease
+	}
+	if config.MaxAttempts == 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.InitialBackoff == 0 {
+		config.InitialBackoff = defaultInitialBackoff
+	}
+	if config.MaxBackoff == 0 {
+		config.MaxBackoff = defaultMaxBackoff
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+	log.Printf("webhook config was loaded successfully")
+	return &config, nil
+}
@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/repository"
+	"medods/internal/repository/attempt"
+	"medods/internal/repository/outbox"
+	"medods/internal/repository/subscription"
+	"time"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 500
+)
+
+var (
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrSubscriptionInactive = errors.New("webhook subscription is inactive")
+	// ErrDeliveryInProgress delivery is claimed by dispatcher or waits for retry
+	ErrDeliveryInProgress = errors.New("webhook delivery is in progress")
+)
+
+// DeliveryFilter conditions of deliveries list, empty fields aren't used
+type DeliveryFilter struct {
+	EventType      string
+	Status         string
+	SubscriptionID string
+	Limit          uint64
+	Offset         uint64
+}
+
+// Deliveries return page of deliveries matched by filter, the newest go first
+func (ws *WebhookService) Deliveries(ctx context.Context, f DeliveryFilter) ([]webhook2.Delivery, error) {
+	filters := make([]repository.Filter[outbox.FieldName], 0, 3)
+	if f.EventType != "" {
+		filters = append(filters, repository.Eq(outbox.EventTypeField, f.EventType))
+	}
+	if f.Status != "" {
+		filters = append(filters, repository.Eq(outbox.StatusField, f.Status))
+	}
+	if f.SubscriptionID != "" {
+		filters = append(filters, repository.Eq(outbox.SubscriptionIdField, f.SubscriptionID))
+	}
+	if f.Limit == 0 {
+		f.Limit = defaultDeliveriesLimit
+	}
+	f.Limit = min(f.Limit, maxDeliveriesLimit)
+
+	events, err := ws.repo.GetMany(ctx, repository.Where(filters...).OrderBy(outbox.IdField, true).Page(f.Limit, f.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("get webhook deliveries: %w", err)
+	}
+	res := make([]webhook2.Delivery, len(events))
+	for i := range events {
+		res[i] = *toDelivery(&events[i])
+	}
+	return res, nil
+}
+
+// Delivery return delivery by id
+func (ws *WebhookService) Delivery(ctx context.Context, id int64) (*webhook2.Delivery, error) {
+	e, err := ws.getDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDelivery(e), nil
+}
+
+// DeliveryAttempts return log of attempts of delivery in order they were made
+func (ws *WebhookService) DeliveryAttempts(ctx context.Context, id int64) ([]webhook2.DeliveryAttempt, error) {
+	stored, err := ws.attemptRepo.GetMany(ctx, repository.Where(repository.Eq(attempt.OutboxIdField, id)).OrderBy(attempt.IdField, false))
+	if err != nil {
+		return nil, fmt.Errorf("get webhook attempts: %w", err)
+	}
+	res := make([]webhook2.DeliveryAttempt, len(stored))
+	for i := range stored {
+		res[i] = *toDeliveryAttempt(&stored[i])
+	}
+	return res, nil
+}
+
+// Replay send delivery again right now, attempt is logged as replay. Pending delivery is replayed only when
+// it is due and isn't claimed by dispatcher, so receiver doesn't get it twice. Successful replay marks delivery
+// as delivered, failed one doesn't change its status and schedule
+func (ws *WebhookService) Replay(ctx context.Context, id int64) (*webhook2.DeliveryAttempt, error) {
+	e, err := ws.getDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := ws.subRepo.GetOne(ctx, repository.Where(repository.Eq(subscription.IdField, e.SubscriptionID)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+		return nil, ErrSubscriptionNotFound
+	case err != nil:
+		return nil, fmt.Errorf("get webhook subscription: %w", err)
+	case !sub.Active:
+		return nil, ErrSubscriptionInactive
+	}
+
+	if e.Status == outbox.StatusPending {
+		//delivery is claimed the same way dispatcher does it, claimed or scheduled one isn't matched
+		now := time.Now()
+		n, err := ws.repo.UpdateFields(ctx, map[outbox.FieldName]interface{}{outbox.NextAttemptAtField: now.Add(ws.conf.Lease)},
+			repository.Eq(outbox.IdField, e.ID), repository.Eq(outbox.StatusField, outbox.StatusPending), repository.LtOrEq(outbox.NextAttemptAtField, now))
+		if err != nil {
+			return nil, fmt.Errorf("claim webhook delivery: %w", err)
+		}
+		if n == 0 {
+			return nil, ErrDeliveryInProgress
+		}
+	}
+
+	a, err := ws.send(ctx, sub, *e, true)
+	if err != nil {
+		return nil, err
+	}
+	set := map[outbox.FieldName]interface{}{outbox.LastErrorField: a.Error}
+	switch {
+	case a.Error == "":
+		set[outbox.StatusField] = outbox.StatusDelivered
+		set[outbox.DeliveredAtField] = sql.NullTime{Time: time.Now(), Valid: true}
+	case e.Status == outbox.StatusPending:
+		//claim is released, dispatcher continues by its schedule
+		set[outbox.NextAttemptAtField] = e.NextAttemptAt
+	}
+	if _, err = ws.repo.UpdateFields(ctx, set, repository.Eq(outbox.IdField, e.ID)); err != nil {
+		return nil, fmt.Errorf("record webhook replay: %w", err)
+	}
+	return toDeliveryAttempt(a), nil
+}
+
+func (ws *WebhookService) getDelivery(ctx context.Context, id int64) (*outbox.Event, error) {
+	e, err := ws.repo.GetOne(ctx, repository.Where(repository.Eq(outbox.IdField, id)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+		return nil, ErrDeliveryNotFound
+	case err != nil:
+		return nil, fmt.Errorf("get webhook delivery: %w", err)
+	}
+	return e, nil
+}
+
+func toDelivery(e *outbox.Event) *webhook2.Delivery {
+	d := &webhook2.Delivery{
+		ID:             e.ID,
+		SubscriptionID: e.SubscriptionID,
+		EventID:        e.EventID,
+		EventType:      e.EventType,
+		Payload:        e.Payload,
+		Status:         e.Status,
+		Attempts:       e.Attempts,
+		NextAttemptAt:  e.NextAttemptAt,
+		LastError:      e.LastError,
+		CreatedAt:      e.CreatedAt,
+	}
+	if e.DeliveredAt.Valid {
+		d.DeliveredAt = &e.DeliveredAt.Time
+	}
+	return d
+}
+
+func toDeliveryAttempt(a *attempt.Attempt) *webhook2.DeliveryAttempt {
+	//headers are written by send, so they are always valid json
+	headers := make(map[string]string)
+	_ = json.Unmarshal([]byte(a.RequestHeaders), &headers)
+	return &webhook2.DeliveryAttempt{
+		ID:             a.ID,
+		DeliveryID:     a.OutboxID,
+		URL:            a.URL,
+		RequestHeaders: headers,
+		ResponseStatus: a.ResponseStatus,
+		Latency:        time.Duration(a.LatencyMs) * time.Millisecond,
+		Error:          a.Error,
+		Replay:         a.Replay,
+		CreatedAt:      a.CreatedAt,
+	}
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"context"
+	"medods/internal/repository"
+	"medods/internal/repository/attempt"
+	"medods/internal/repository/outbox"
+	"medods/internal/repository/subscription"
+	"net/http"
+	"time"
+)
+
+// OutboxRepository storage of webhook events, implemented by *outbox.OutboxRepository and *outbox.MemoryRepository
+type OutboxRepository interface {
+	Create(ctx context.Context, model *outbox.Event) error
+	GetOne(ctx context.Context, q repository.Query[outbox.FieldName]) (*outbox.Event, error)
+	GetMany(ctx context.Context, q repository.Query[outbox.FieldName]) ([]outbox.Event, error)
+	UpdateFields(ctx context.Context, set map[outbox.FieldName]interface{}, filters ...repository.Filter[outbox.FieldName]) (int64, error)
+	ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]outbox.Event, error)
+}
+
+// SubscriptionRepository storage of registered webhook endpoints
+type SubscriptionRepository interface {
+	Create(ctx context.Context, model *subscription.Subscription) error
+	GetOne(ctx context.Context, q repository.Query[subscription.FieldName]) (*subscription.Subscription, error)
+	GetMany(ctx context.Context, q repository.Query[subscription.FieldName]) ([]subscription.Subscription, error)
+	UpdateFields(ctx context.Context, set map[subscription.FieldName]interface{}, filters ...repository.Filter[subscription.FieldName]) (int64, error)
+	Delete(ctx context.Context, filters ...repository.Filter[subscription.FieldName]) (int64, error)
+}
+
+// AttemptRepository log of delivery attempts
+type AttemptRepository interface {
+	Create(ctx context.Context, model *attempt.Attempt) error
+	GetMany(ctx context.Context, q repository.Query[attempt.FieldName]) ([]attempt.Attempt, error)
+}
+
+// Sender client posting webhooks to receivers
+type Sender interface {
+	SendWebhook(url string, body []byte, header http.Header) (int, error)
+}
@@ -0,0 +1,211 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"medods/internal/domain/webhook"
+	"medods/internal/repository"
+	"medods/internal/repository/attempt"
+	"medods/internal/repository/outbox"
+	"medods/internal/repository/subscription"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// WebhookService deliver webhooks through transactional outbox: events are stored together with
+// changes which caused them and are delivered in background until receiver accepts them
+type WebhookService struct {
+	conf        *Config
+	repo        OutboxRepository
+	subRepo     SubscriptionRepository
+	attemptRepo AttemptRepository
+	sender      Sender
+}
+
+func NewWService(conf *Config, repo OutboxRepository, subRepo SubscriptionRepository, attemptRepo AttemptRepository, sender Sender) *WebhookService {
+	return &WebhookService{conf: conf, repo: repo, subRepo: subRepo, attemptRepo: attemptRepo, sender: sender}
+}
+
+// Publish store event of type for delivery to every active subscription of this type,
+// data is one of event types of domain. It joins transaction carried by ctx
+func (ws *WebhookService) Publish(ctx context.Context, eventType string, data interface{}) error {
+	subs, err := ws.subRepo.GetMany(ctx, repository.Where(repository.Eq(subscription.ActiveField, true)))
+	if err != nil {
+		return fmt.Errorf("get webhook subscriptions: %w", err)
+	}
+
+	event := webhook.Event{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		Version:   webhook.SchemaVersion,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode webhook event: %w", err)
+	}
+	for i := range subs {
+		if !subscribed(&subs[i], eventType) {
+			continue
+		}
+		err = ws.repo.Create(ctx, &outbox.Event{
+			SubscriptionID: subs[i].ID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        string(body),
+			Status:         outbox.StatusPending,
+			NextAttemptAt:  time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("enqueue webhook: %w", err)
+		}
+	}
+	return nil
+}
+
+// Run deliver due events periodically until ctx is done
+func (ws *WebhookService) Run(ctx context.Context) {
+	ticker := time.NewTicker(ws.conf.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := ws.Dispatch(ctx); err != nil {
+				log.Printf("webhook dispatcher error: %s", err)
+			}
+		}
+	}
+}
+
+// Dispatch deliver one batch of due events and return number of processed events
+func (ws *WebhookService) Dispatch(ctx context.Context) (int, error) {
+	events, err := ws.repo.ClaimDue(ctx, uint64(ws.conf.BatchSize), ws.conf.Lease)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	//subscriptions are loaded once for whole batch
+	ids := make([]string, 0, len(events))
+	for _, v := range events {
+		ids = append(ids, v.SubscriptionID)
+	}
+	subs, err := ws.subRepo.GetMany(ctx, repository.Where(repository.In(subscription.IdField, ids...)))
+	if err != nil {
+		return 0, fmt.Errorf("get webhook subscriptions: %w", err)
+	}
+	byID := make(map[string]*subscription.Subscription, len(subs))
+	for i := range subs {
+		byID[subs[i].ID] = &subs[i]
+	}
+
+	for _, v := range events {
+		if err = ws.deliver(ctx, v, byID[v.SubscriptionID]); err != nil {
+			return 0, err
+		}
+	}
+	return len(events), nil
+}
+
+// deliver send signed event to endpoint of subscription and record result of attempt. Failed event
+// is retried after backoff or dead-lettered when attempts are exhausted or subscription is gone
+func (ws *WebhookService) deliver(ctx context.Context, e outbox.Event, sub *subscription.Subscription) error {
+	var sendErr error
+	//webhooks of deleted or disabled subscriptions aren't sent and aren't retried
+	retryable := true
+	switch {
+	case sub == nil:
+		sendErr, retryable = errors.New("subscription was deleted"), false
+	case !sub.Active:
+		sendErr, retryable = errors.New("subscription is inactive"), false
+	default:
+		a, err := ws.send(ctx, sub, e, false)
+		if err != nil {
+			return err
+		}
+		if a.Error != "" {
+			sendErr = errors.New(a.Error)
+		}
+	}
+	attempts := e.Attempts + 1
+
+	now := time.Now()
+	set := map[outbox.FieldName]interface{}{outbox.AttemptsField: attempts}
+	switch {
+	case sendErr == nil:
+		set[outbox.StatusField] = outbox.StatusDelivered
+		set[outbox.DeliveredAtField] = sql.NullTime{Time: now, Valid: true}
+		set[outbox.LastErrorField] = ""
+	case !retryable || attempts >= ws.conf.MaxAttempts:
+		set[outbox.StatusField] = outbox.StatusDead
+		set[outbox.LastErrorField] = sendErr.Error()
+		log.Printf("webhook %d is dead after %d attempts: %s", e.ID, attempts, sendErr)
+	default:
+		set[outbox.NextAttemptAtField] = now.Add(ws.backoff(attempts))
+		set[outbox.LastErrorField] = sendErr.Error()
+	}
+
+	if _, err := ws.repo.UpdateFields(ctx, set, repository.Eq(outbox.IdField, e.ID)); err != nil {
+		return fmt.Errorf("record webhook attempt: %w", err)
+	}
+	return nil
+}
+
+// send post payload of event to endpoint of subscription and log attempt, timestamp and signature
+// are set per attempt. Failure of delivery is stored in attempt, returned error means attempt isn't logged
+func (ws *WebhookService) send(ctx context.Context, sub *subscription.Subscription, e outbox.Event, replay bool) (*attempt.Attempt, error) {
+	body := []byte(e.Payload)
+	timestamp := time.Now().Unix()
+	header := http.Header{}
+	header.Set(HeaderID, e.EventID)
+	header.Set(HeaderEvent, e.EventType)
+	header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	start := time.Now()
+	status, sendErr := ws.sender.SendWebhook(sub.URL, body, header)
+	a := &attempt.Attempt{
+		OutboxID:       e.ID,
+		URL:            sub.URL,
+		ResponseStatus: status,
+		LatencyMs:      time.Since(start).Milliseconds(),
+		Replay:         replay,
+	}
+	if sendErr != nil {
+		a.Error = sendErr.Error()
+	}
+	requestHeaders := make(map[string]string, len(header))
+	for k := range header {
+		requestHeaders[k] = header.Get(k)
+	}
+	rawHeaders, err := json.Marshal(requestHeaders)
+	if err != nil {
+		return nil, fmt.Errorf("encode webhook headers: %w", err)
+	}
+	a.RequestHeaders = string(rawHeaders)
+
+	if err = ws.attemptRepo.Create(ctx, a); err != nil {
+		return nil, fmt.Errorf("log webhook attempt: %w", err)
+	}
+	return a, nil
+}
+
+// backoff return delay after failed attempt, it grows exponentially up to MaxBackoff
+func (ws *WebhookService) backoff(attempts int) time.Duration {
+	delay := ws.conf.InitialBackoff
+	for i := 1; i < attempts && delay < ws.conf.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, ws.conf.MaxBackoff)
+}
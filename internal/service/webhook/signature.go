@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// headers of webhook request
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	signatureVersion = "v1"
+)
+
+// Sign return value of signature header of body sent at timestamp (unix seconds):
+// "v1=" and hex of HMAC-SHA256 of "timestamp.body" keyed by secret of subscription.
+// Timestamp is signed too, so receiver can reject replayed requests
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
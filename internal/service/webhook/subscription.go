@@ -0,0 +1,180 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	webhook2 "medods/internal/domain/webhook"
+	"medods/internal/repository"
+	"medods/internal/repository/subscription"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	eventTypesSeparator = ","
+	secretLen           = 32
+)
+
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrInvalidSubscription  = errors.New("invalid webhook subscription")
+)
+
+// SubscriptionUpdate changes of subscription, nil fields are left as is
+type SubscriptionUpdate struct {
+	URL        *string
+	EventTypes []string
+	Active     *bool
+}
+
+// CreateSubscription register endpoint for events of given types, secret for signatures is generated
+func (ws *WebhookService) CreateSubscription(ctx context.Context, endpoint string, eventTypes []string) (*webhook2.Subscription, error) {
+	if err := ws.validateURL(endpoint); err != nil {
+		return nil, err
+	}
+	if err := validateEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+	secret := make([]byte, secretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("generate webhook secret: %w", err)
+	}
+
+	model := &subscription.Subscription{
+		ID:         uuid.NewString(),
+		URL:        endpoint,
+		Secret:     base64.RawURLEncoding.EncodeToString(secret),
+		EventTypes: strings.Join(eventTypes, eventTypesSeparator),
+		Active:     true,
+	}
+	if err := ws.subRepo.Create(ctx, model); err != nil {
+		return nil, fmt.Errorf("create webhook subscription: %w", err)
+	}
+	return toSubscription(model), nil
+}
+
+// Subscriptions return all registered subscriptions
+func (ws *WebhookService) Subscriptions(ctx context.Context) ([]webhook2.Subscription, error) {
+	stored, err := ws.subRepo.GetMany(ctx, repository.Query[subscription.FieldName]{}.OrderBy(subscription.CreatedAtField, false))
+	if err != nil {
+		return nil, fmt.Errorf("get webhook subscriptions: %w", err)
+	}
+	res := make([]webhook2.Subscription, len(stored))
+	for i := range stored {
+		res[i] = *toSubscription(&stored[i])
+	}
+	return res, nil
+}
+
+// Subscription return subscription by id
+func (ws *WebhookService) Subscription(ctx context.Context, id string) (*webhook2.Subscription, error) {
+	stored, err := ws.subRepo.GetOne(ctx, repository.Where(repository.Eq(subscription.IdField, id)))
+	switch {
+	case err != nil && errors.Is(err, sql.ErrNoRows):
+		return nil, ErrSubscriptionNotFound
+	case err != nil:
+		return nil, fmt.Errorf("get webhook subscription: %w", err)
+	}
+	return toSubscription(stored), nil
+}
+
+// UpdateSubscription change subscription and return its new state
+func (ws *WebhookService) UpdateSubscription(ctx context.Context, id string, upd SubscriptionUpdate) (*webhook2.Subscription, error) {
+	set := make(map[subscription.FieldName]interface{})
+	if upd.URL != nil {
+		if err := ws.validateURL(*upd.URL); err != nil {
+			return nil, err
+		}
+		set[subscription.URLField] = *upd.URL
+	}
+	if upd.EventTypes != nil {
+		if err := validateEventTypes(upd.EventTypes); err != nil {
+			return nil, err
+		}
+		set[subscription.EventTypesField] = strings.Join(upd.EventTypes, eventTypesSeparator)
+	}
+	if upd.Active != nil {
+		set[subscription.ActiveField] = *upd.Active
+	}
+
+	if len(set) > 0 {
+		n, err := ws.subRepo.UpdateFields(ctx, set, repository.Eq(subscription.IdField, id))
+		if err != nil {
+			return nil, fmt.Errorf("update webhook subscription: %w", err)
+		}
+		if n == 0 {
+			return nil, ErrSubscriptionNotFound
+		}
+	}
+	return ws.Subscription(ctx, id)
+}
+
+// DeleteSubscription remove subscription, its pending webhooks are dead-lettered by dispatcher
+func (ws *WebhookService) DeleteSubscription(ctx context.Context, id string) error {
+	n, err := ws.subRepo.Delete(ctx, repository.Eq(subscription.IdField, id))
+	if err != nil {
+		return fmt.Errorf("delete webhook subscription: %w", err)
+	}
+	if n == 0 {
+		return ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+// validateURL check that endpoint is absolute https url of public host, plain http and internal hosts
+// are accepted only when insecure targets are allowed. Names are checked again by sender when they are resolved
+func (ws *WebhookService) validateURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("%w: url must be absolute http or https url", ErrInvalidSubscription)
+	}
+	if ws.conf.AllowInsecureTargets {
+		return nil
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("%w: url must be https url", ErrInvalidSubscription)
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: url must not point to internal host", ErrInvalidSubscription)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !webhook2.PublicAddr(addr) {
+		return fmt.Errorf("%w: url must not point to internal host", ErrInvalidSubscription)
+	}
+	return nil
+}
+
+func validateEventTypes(eventTypes []string) error {
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("%w: at least one event type is required", ErrInvalidSubscription)
+	}
+	for _, v := range eventTypes {
+		if !slices.Contains(webhook2.EventTypes, v) {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidSubscription, v)
+		}
+	}
+	return nil
+}
+
+func toSubscription(model *subscription.Subscription) *webhook2.Subscription {
+	return &webhook2.Subscription{
+		ID:         model.ID,
+		URL:        model.URL,
+		Secret:     model.Secret,
+		EventTypes: strings.Split(model.EventTypes, eventTypesSeparator),
+		Active:     model.Active,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+// subscribed report whether subscription receives events of type
+func subscribed(model *subscription.Subscription, eventType string) bool {
+	return slices.Contains(strings.Split(model.EventTypes, eventTypesSeparator), eventType)
+}
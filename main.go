@@ -1,17 +1,22 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/joho/godotenv"
-	"medods/database"
+	"log"
 	"medods/internal/api"
+	"medods/internal/app"
 	"medods/internal/client/external"
-	"medods/internal/repository/blacklist"
-	token2 "medods/internal/repository/token"
-	user2 "medods/internal/repository/user"
+	"medods/internal/service/audit"
+	client2 "medods/internal/service/client"
+	"medods/internal/service/key"
+	"medods/internal/service/revocation"
 	"medods/internal/service/token"
 	"medods/internal/service/user"
-	"net/http"
+	"medods/internal/service/webhook"
+	"os"
 )
 
 func main() {
@@ -20,34 +25,120 @@ func main() {
 		panic(err)
 	}
 
-	//database
-	dbConf, err := database.NewConfig()
+	//subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			err = runMigrate(os.Args[2:])
+		case "token", "sessions", "keys", "purge":
+			err = runAdmin(os.Args[1], os.Args[2:])
+		default:
+			err = fmt.Errorf("unknown command %q", os.Args[1])
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	svc, err := newServices()
 	if err != nil {
 		panic(err)
 	}
-	db, err := database.NewDatabase(dbConf)
+	go svc.keyRing.Run(context.Background())
+	go svc.ts.RunJanitor(context.Background())
+	go svc.ws.Run(context.Background())
+	if svc.cache != nil {
+		go func() {
+			if err := svc.cache.Run(context.Background()); err != nil {
+				log.Printf("revocation cache error: %s", err)
+			}
+		}()
+	}
+
+	oauthClientConf, err := client2.NewConfig()
+	if err != nil {
+		panic(err)
+	}
+	cs, err := client2.NewCService(oauthClientConf)
 	if err != nil {
 		panic(err)
 	}
 
+	httpConf, err := api.NewConfig()
+	if err != nil {
+		panic(err)
+	}
+	ipr, err := api.NewIPResolver(httpConf)
+	if err != nil {
+		panic(err)
+	}
+
+	api.StartHttpServer(svc.ts, svc.us, svc.ws, svc.audit, cs, ipr)
+}
+
+// services shared by server and admin commands
+type services struct {
+	keyRing *key.KeyRing
+	ts      *token.TokenService
+	us      *user.UserService
+	ws      *webhook.WebhookService
+	audit   *audit.AuditLogger
+	// cache of blacklist, nil when it is disabled or storage is local
+	cache *revocation.Cache
+}
+
+func newServices() (*services, error) {
 	//repositories
-	uRepo := user2.NewRepository(db)
-	tRepo := token2.NewRepository(db)
-	blRepo := blacklist.NewRepository(db)
+	storageConf, err := app.NewStorageConfig()
+	if err != nil {
+		return nil, err
+	}
+	st, err := app.NewStorage(context.Background(), storageConf)
+	if err != nil {
+		return nil, err
+	}
 
-	//http client
-	httpClient := &http.Client{}
-	clientConf, err := external.NewConfig(httpClient)
+	//signing keys
+	keyConf, err := key.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	keyRing := key.NewKeyRing(keyConf, st.SigningKeyRepo, st.Uow)
+	if err = keyRing.Init(context.Background()); err != nil {
+		return nil, err
+	}
 
+	webhookConf, err := webhook.NewConfig()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	client := external.NewClient(clientConf)
+	//http client of webhook receivers
+	client := external.NewClient(external.NewHTTPClient(webhookConf.Timeout, webhookConf.AllowInsecureTargets))
 
 	//services
-	ts := token.NewTService(client, blRepo, tRepo)
-	us := user.NewUService(uRepo, ts)
+	ws := webhook.NewWService(webhookConf, st.OutboxRepo, st.SubscriptionRepo, st.AttemptRepo, client)
+
+	tokenConf, err := token.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	//revocation cache is kept in sync by notifications of database, so local storage doesn't need it
+	cacheConf, err := revocation.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	var cache *revocation.Cache
+	var revoked token.RevocationCache
+	if st.BlacklistSource != nil && !cacheConf.Disabled {
+		cache = revocation.NewCache(cacheConf, st.BlacklistRepo, st.BlacklistSource)
+		revoked = cache
+	}
+
+	al := audit.NewAuditLogger(st.AuditRepo)
+	ts := token.NewTService(tokenConf, ws, st.BlacklistRepo, st.TokenRepo, keyRing, st.Uow, revoked, al)
+	us := user.NewUService(st.UserRepo, ts, st.Uow, al)
 
-	api.StartHttpServer(ts, us)
+	return &services{keyRing: keyRing, ts: ts, us: us, ws: ws, audit: al, cache: cache}, nil
 }
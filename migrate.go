@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"medods/database"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+)
+
+const migrateUsage = "usage: migrate up|down|status|redo"
+
+// runMigrate run migrate subcommand against database configured by PG_* variables
+func runMigrate(args []string) error {
+	if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+
+	dbConf, err := database.NewConfig()
+	if err != nil {
+		return err
+	}
+	db, err := database.NewDatabase(dbConf)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		res, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			log.Printf("no pending migrations")
+		}
+		for _, v := range res {
+			log.Print(v)
+		}
+	case "down":
+		res, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+		log.Print(res)
+	case "redo":
+		res, err := migrator.Redo(ctx)
+		if err != nil {
+			return err
+		}
+		for _, v := range res {
+			log.Print(v)
+		}
+	case "status":
+		res, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "APPLIED AT\tMIGRATION")
+		for _, v := range res {
+			appliedAt := "pending"
+			if !v.AppliedAt.IsZero() {
+				appliedAt = v.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", appliedAt, filepath.Base(v.Source.Path))
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists signing_keys(
+    id varchar primary key,
+    algorithm varchar not null,
+    secret varchar not null,
+    status varchar not null,
+    not_before timestamptz not null default now(),
+    not_after timestamptz
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists signing_keys;
+-- +goose StatementEnd
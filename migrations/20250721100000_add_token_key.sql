@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tokens add column if not exists token_key varchar not null default '';
+create index if not exists tokens_token_key_idx on tokens(token_key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists tokens_token_key_idx;
+alter table tokens drop column if exists token_key;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tokens add column if not exists family_id varchar not null default '';
+alter table tokens add column if not exists parent_id bigint references tokens(id);
+alter table tokens add column if not exists generation int not null default 0;
+update tokens set family_id = gen_random_uuid()::varchar where family_id = '';
+create index if not exists tokens_family_id_idx on tokens(family_id);
+create index if not exists tokens_parent_id_idx on tokens(parent_id);
+
+alter table blacklists add column if not exists token_key varchar not null default '';
+create index if not exists blacklists_token_key_idx on blacklists(token_key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists blacklists_token_key_idx;
+alter table blacklists drop column if exists token_key;
+
+drop index if exists tokens_parent_id_idx;
+drop index if exists tokens_family_id_idx;
+alter table tokens drop column if exists generation;
+alter table tokens drop column if exists parent_id;
+alter table tokens drop column if exists family_id;
+-- +goose StatementEnd
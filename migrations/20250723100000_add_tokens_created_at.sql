@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tokens add column if not exists created_at timestamptz not null default now();
+create index if not exists tokens_user_guid_active_idx on tokens(user_guid, active);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists tokens_user_guid_active_idx;
+alter table tokens drop column if exists created_at;
+-- +goose StatementEnd
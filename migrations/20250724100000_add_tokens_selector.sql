@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tokens add column if not exists selector varchar not null default '';
+-- tokens issued before selectors can't be looked up anymore
+update tokens set active = false where selector = '';
+create unique index if not exists tokens_selector_idx on tokens(selector) where selector <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists tokens_selector_idx;
+alter table tokens drop column if exists selector;
+-- +goose StatementEnd
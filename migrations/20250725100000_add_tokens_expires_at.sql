@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tokens add column if not exists expires_at timestamptz not null default now();
+update tokens set expires_at = created_at + interval '7 days';
+alter table tokens alter column expires_at drop default;
+create index if not exists tokens_expires_at_idx on tokens(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists tokens_expires_at_idx;
+alter table tokens drop column if exists expires_at;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table blacklists add column if not exists jti varchar not null default '';
+-- entries blocked before expiry was tracked live as long as the longest access token
+alter table blacklists add column if not exists expires_at timestamptz not null default now() + interval '1 day';
+alter table blacklists alter column expires_at drop default;
+alter table blacklists alter column access_token set default '';
+create index if not exists blacklists_jti_idx on blacklists(jti) where jti <> '';
+create index if not exists blacklists_expires_at_idx on blacklists(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists blacklists_expires_at_idx;
+drop index if exists blacklists_jti_idx;
+alter table blacklists alter column access_token drop default;
+alter table blacklists drop column if exists expires_at;
+alter table blacklists drop column if exists jti;
+-- +goose StatementEnd
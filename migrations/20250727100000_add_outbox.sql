@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists outbox(
+    id bigint generated always as identity primary key,
+    path varchar not null,
+    payload jsonb not null,
+    status varchar not null default 'pending',
+    attempts int not null default 0,
+    next_attempt_at timestamptz not null default now(),
+    last_error varchar not null default '',
+    created_at timestamptz not null default now(),
+    delivered_at timestamptz
+);
+create index if not exists outbox_due_idx on outbox(next_attempt_at) where status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists outbox;
+-- +goose StatementEnd
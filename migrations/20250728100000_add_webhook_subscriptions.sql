@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists webhook_subscriptions(
+    id varchar primary key,
+    url varchar not null,
+    secret varchar not null,
+    event_types varchar not null,
+    active boolean not null default true,
+    created_at timestamptz not null default now()
+);
+alter table outbox add column if not exists subscription_id varchar not null default '';
+alter table outbox add column if not exists event_id varchar not null default '';
+alter table outbox add column if not exists event_type varchar not null default '';
+-- events for hard-coded endpoint have no subscription and can't be delivered anymore
+update outbox set status = 'dead', last_error = 'event has no subscription' where status = 'pending';
+alter table outbox drop column if exists path;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table outbox add column if not exists path varchar not null default '/security';
+alter table outbox drop column if exists event_type;
+alter table outbox drop column if exists event_id;
+alter table outbox drop column if exists subscription_id;
+drop table if exists webhook_subscriptions;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists webhook_attempts(
+    id bigint generated always as identity primary key,
+    outbox_id bigint not null references outbox(id) on delete cascade,
+    url varchar not null,
+    request_headers jsonb not null default '{}',
+    response_status int not null default 0,
+    latency_ms bigint not null default 0,
+    error varchar not null default '',
+    replay boolean not null default false,
+    created_at timestamptz not null default now()
+);
+create index if not exists webhook_attempts_outbox_id_idx on webhook_attempts(outbox_id);
+create index if not exists outbox_event_type_idx on outbox(event_type, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists outbox_event_type_idx;
+drop table if exists webhook_attempts;
+-- +goose StatementEnd
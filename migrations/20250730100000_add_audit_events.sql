@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists audit_events(
+    id bigint generated always as identity primary key,
+    occurred_at timestamptz not null default now(),
+    type varchar not null,
+    outcome varchar not null,
+    user_guid varchar not null default '',
+    session_id varchar not null default '',
+    ip varchar not null default '',
+    user_agent varchar not null default '',
+    reason varchar not null default ''
+);
+create index if not exists audit_events_occurred_at_idx on audit_events(occurred_at);
+create index if not exists audit_events_user_guid_idx on audit_events(user_guid, occurred_at);
+
+-- audit log is append-only
+create or replace function audit_events_append_only() returns trigger as $$
+begin
+    raise exception 'audit_events is append-only';
+end;
+$$ language plpgsql;
+create trigger audit_events_append_only before update or delete on audit_events
+    for each row execute function audit_events_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists audit_events;
+drop function if exists audit_events_append_only();
+-- +goose StatementEnd
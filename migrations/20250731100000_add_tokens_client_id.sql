@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tokens add column if not exists client_id varchar not null default '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table tokens drop column if exists client_id;
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS sql migrations embedded to binary, they are applied by migrate subcommand or on startup
+//
+//go:embed *.sql
+var FS embed.FS